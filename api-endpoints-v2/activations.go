@@ -17,9 +17,8 @@ type ActivateEndpointOptions struct {
 	VersionNumber int
 }
 
-func ActivateEndpoint(options *ActivateEndpointOptions, activation *Activation) (*Activation, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) ActivateEndpoint(options *ActivateEndpointOptions, activation *Activation) (*Activation, error) {
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions/%d/activate",
@@ -33,7 +32,7 @@ func ActivateEndpoint(options *ActivateEndpointOptions, activation *Activation)
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if client.IsError(res) {
 		return nil, client.NewAPIError(res)
@@ -42,9 +41,12 @@ func ActivateEndpoint(options *ActivateEndpointOptions, activation *Activation)
 	return activation, nil
 }
 
-func DeactivateEndpoint(options *ActivateEndpointOptions, activation *Activation) (*Activation, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func ActivateEndpoint(options *ActivateEndpointOptions, activation *Activation) (*Activation, error) {
+	return defaultClient.ActivateEndpoint(options, activation)
+}
+
+func (c *Client) DeactivateEndpoint(options *ActivateEndpointOptions, activation *Activation) (*Activation, error) {
+	req, err := c.session.NewJSONRequest(
 		"DELETE",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions/%d/deactivate",
@@ -58,7 +60,7 @@ func DeactivateEndpoint(options *ActivateEndpointOptions, activation *Activation
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if client.IsError(res) {
 		return nil, client.NewAPIError(res)
@@ -67,6 +69,10 @@ func DeactivateEndpoint(options *ActivateEndpointOptions, activation *Activation
 	return activation, nil
 }
 
+func DeactivateEndpoint(options *ActivateEndpointOptions, activation *Activation) (*Activation, error) {
+	return defaultClient.DeactivateEndpoint(options, activation)
+}
+
 func IsActive(endpoint *Endpoint, network string) bool {
 	if network == "production" {
 		if endpoint.ProductionStatus == StatusPending || endpoint.ProductionStatus == StatusActive {
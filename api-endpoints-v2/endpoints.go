@@ -2,6 +2,8 @@ package apiendpoints
 
 import (
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
@@ -67,15 +69,18 @@ type CreateEndpointOptions struct {
 	Hostnames  []string `json:"apiEndPointHosts,omitempty"`
 }
 
-func CreateEndpoint(options *CreateEndpointOptions) (*Endpoint, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) CreateEndpoint(options *CreateEndpointOptions) (*Endpoint, error) {
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/api-definitions/v2/endpoints",
 		options,
 	)
 
-	return call(req, err)
+	return c.call(req, err)
+}
+
+func CreateEndpoint(options *CreateEndpointOptions) (*Endpoint, error) {
+	return defaultClient.CreateEndpoint(options)
 }
 
 type CreateEndpointFromFileOptions struct {
@@ -83,13 +88,18 @@ type CreateEndpointFromFileOptions struct {
 	Format     string
 	ContractId string
 	GroupId    int
+
+	// Content, if set, is uploaded instead of the File, named FileName
+	Content  io.Reader
+	FileName string
 }
 
-func CreateEndpointFromFile(options *CreateEndpointFromFileOptions) (*Endpoint, error) {
-	req, err := client.NewMultiPartFormDataRequest(
-		Config,
+func (c *Client) CreateEndpointFromFile(options *CreateEndpointFromFileOptions) (*Endpoint, error) {
+	req, err := c.newFileRequest(
 		"/api-definitions/v2/endpoints/files",
 		options.File,
+		options.Content,
+		options.FileName,
 		map[string]string{
 			"contractId":       options.ContractId,
 			"groupId":          strconv.Itoa(options.GroupId),
@@ -97,7 +107,11 @@ func CreateEndpointFromFile(options *CreateEndpointFromFileOptions) (*Endpoint,
 		},
 	)
 
-	return call(req, err)
+	return c.call(req, err)
+}
+
+func CreateEndpointFromFile(options *CreateEndpointFromFileOptions) (*Endpoint, error) {
+	return defaultClient.CreateEndpointFromFile(options)
 }
 
 type UpdateEndpointFromFileOptions struct {
@@ -105,25 +119,44 @@ type UpdateEndpointFromFileOptions struct {
 	Version    int
 	File       string
 	Format     string
+
+	// Content, if set, is uploaded instead of the File, named FileName
+	Content  io.Reader
+	FileName string
 }
 
-func UpdateEndpointFromFile(options *UpdateEndpointFromFileOptions) (*Endpoint, error) {
+func (c *Client) UpdateEndpointFromFile(options *UpdateEndpointFromFileOptions) (*Endpoint, error) {
 	url := fmt.Sprintf(
 		"/api-definitions/v2/endpoints/%d/versions/%d/file",
 		options.EndpointId,
 		options.Version,
 	)
 
-	req, err := client.NewMultiPartFormDataRequest(
-		Config,
+	req, err := c.newFileRequest(
 		url,
 		options.File,
+		options.Content,
+		options.FileName,
 		map[string]string{
 			"importFileFormat": options.Format,
 		},
 	)
 
-	return call(req, err)
+	return c.call(req, err)
+}
+
+func UpdateEndpointFromFile(options *UpdateEndpointFromFileOptions) (*Endpoint, error) {
+	return defaultClient.UpdateEndpointFromFile(options)
+}
+
+// newFileRequest creates a request uploading the file at path, or streaming
+// content if set, in the importFile form field
+func (c *Client) newFileRequest(url, path string, content io.Reader, fileName string, params map[string]string) (*http.Request, error) {
+	if content == nil {
+		return c.session.NewMultiPartFormDataRequest(url, path, params)
+	}
+
+	return c.session.NewMultiPartFormDataStreamRequest(url, "importFile", fileName, content, params)
 }
 
 type ListEndpointOptions struct {
@@ -148,6 +181,10 @@ type EndpointList struct {
 }
 
 func (list *EndpointList) ListEndpoints(options *ListEndpointOptions) error {
+	return defaultClient.ListEndpoints(list, options)
+}
+
+func (c *Client) ListEndpoints(list *EndpointList, options *ListEndpointOptions) error {
 	q, err := query.Values(options)
 	if err != nil {
 		return err
@@ -158,12 +195,12 @@ func (list *EndpointList) ListEndpoints(options *ListEndpointOptions) error {
 		q.Encode(),
 	)
 
-	req, err := client.NewJSONRequest(Config, "GET", url, nil)
+	req, err := c.session.NewJSONRequest("GET", url, nil)
 	if err != nil {
 		return err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -179,9 +216,57 @@ func (list *EndpointList) ListEndpoints(options *ListEndpointOptions) error {
 	return nil
 }
 
-func RemoveEndpoint(endpointId int) (*Endpoint, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+// EndpointIterator walks the endpoints of all the pages of an endpoint list. Set
+// Prefetch before the first call to Next to fetch each page while the previous
+// one is walked.
+type EndpointIterator struct {
+	*client.Pager
+}
+
+// Value returns the current endpoint
+func (it *EndpointIterator) Value() Endpoint {
+	endpoint, _ := it.Pager.Value().(Endpoint)
+	return endpoint
+}
+
+// ForEach calls fn with each endpoint, stopping at the first error
+func (it *EndpointIterator) ForEach(fn func(endpoint Endpoint) error) error {
+	return it.Pager.ForEach(func(item interface{}) error {
+		return fn(item.(Endpoint))
+	})
+}
+
+// IterEndpoints returns an iterator over the endpoints listed by ListEndpoints
+// with options, which may be nil, fetching the pages as needed. The Page of
+// options is ignored.
+func (c *Client) IterEndpoints(options *ListEndpointOptions) *EndpointIterator {
+	opts := ListEndpointOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return &EndpointIterator{client.NewPager(func(page int) ([]interface{}, bool, error) {
+		opts := opts
+		opts.Page = page
+		list := &EndpointList{}
+		if err := c.ListEndpoints(list, &opts); err != nil {
+			return nil, false, err
+		}
+
+		items := make([]interface{}, len(list.APIEndPoints))
+		for i, endpoint := range list.APIEndPoints {
+			items[i] = endpoint
+		}
+		return items, client.LastPage(page, list.PageSize, list.TotalSize), nil
+	})}
+}
+
+func IterEndpoints(options *ListEndpointOptions) *EndpointIterator {
+	return defaultClient.IterEndpoints(options)
+}
+
+func (c *Client) RemoveEndpoint(endpointId int) (*Endpoint, error) {
+	req, err := c.session.NewJSONRequest(
 		"DELETE",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d",
@@ -194,7 +279,7 @@ func RemoveEndpoint(endpointId int) (*Endpoint, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if client.IsError(res) {
 		return nil, client.NewAPIError(res)
@@ -203,3 +288,7 @@ func RemoveEndpoint(endpointId int) (*Endpoint, error) {
 	rep := &Endpoint{}
 	return rep, nil
 }
+
+func RemoveEndpoint(endpointId int) (*Endpoint, error) {
+	return defaultClient.RemoveEndpoint(endpointId)
+}
@@ -0,0 +1,99 @@
+package apiendpoints
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestIterEndpoints(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/api-definitions/v2/endpoints").
+		MatchParam("page", "^1$").
+		MatchParam("contractId", "1-2AB34C").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"apiEndPoints": []map[string]interface{}{{"apiEndPointId": 1}, {"apiEndPointId": 2}},
+			"page":         1,
+			"pageSize":     2,
+			"totalSize":    3,
+		})
+	gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/api-definitions/v2/endpoints").
+		MatchParam("page", "^2$").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"apiEndPoints": []map[string]interface{}{{"apiEndPointId": 3}},
+			"page":         2,
+			"pageSize":     2,
+			"totalSize":    3,
+		})
+
+	endpoints := New(client.NewSession(config)).IterEndpoints(&ListEndpointOptions{ContractId: "1-2AB34C", PageSize: 2})
+	endpoints.Prefetch = true
+	var ids []int
+	assert.NoError(t, endpoints.ForEach(func(endpoint Endpoint) error {
+		ids = append(ids, endpoint.APIEndPointID)
+		return nil
+	}))
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.True(t, gock.IsDone())
+}
+
+func TestCreateEndpointFromFile_Content(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("importFile")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		content, _ := ioutil.ReadAll(file)
+		if r.URL.Path != "/api-definitions/v2/endpoints/files" || header.Filename != "api.json" ||
+			string(content) != `{"swagger":"2.0"}` || r.FormValue("contractId") != "1-2AB34C" || r.FormValue("groupId") != "12345" {
+			http.Error(w, "unexpected upload", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"apiEndPointId": 1, "apiEndPointName": "example"}`))
+	}))
+	defer server.Close()
+
+	sessionConfig := config
+	sessionConfig.Host = server.URL
+	session := client.NewSession(sessionConfig)
+	session.HTTPClient = server.Client()
+
+	endpoint, err := New(session).CreateEndpointFromFile(&CreateEndpointFromFileOptions{
+		Format:     "swagger",
+		ContractId: "1-2AB34C",
+		GroupId:    12345,
+		Content:    strings.NewReader(`{"swagger":"2.0"}`),
+		FileName:   "api.json",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, endpoint.APIEndPointID)
+}
+
+func TestNewFileRequest_File(t *testing.T) {
+	file, err := ioutil.TempFile("", "api-*.json")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	file.WriteString(`{"swagger":"2.0"}`)
+	file.Close()
+
+	req, err := New(client.NewSession(config)).newFileRequest("/api-definitions/v2/endpoints/files", file.Name(), nil, "", map[string]string{"importFileFormat": "swagger"})
+	require.NoError(t, err)
+	assert.NotNil(t, req.GetBody, "a file upload can be sent again")
+	assert.True(t, req.ContentLength > 0)
+}
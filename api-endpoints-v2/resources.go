@@ -45,9 +45,8 @@ type ResourceSettings struct {
 	InheritsFromEndpoint bool          `json:"inheritsFromEndpoint"`
 }
 
-func GetResources(endpointId int, version int) (*Resources, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) GetResources(endpointId int, version int) (*Resources, error) {
+	req, err := c.session.NewJSONRequest(
 		"GET",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions/%d/resources",
@@ -61,7 +60,7 @@ func GetResources(endpointId int, version int) (*Resources, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if client.IsError(res) {
 		return nil, client.NewAPIError(res)
@@ -74,3 +73,7 @@ func GetResources(endpointId int, version int) (*Resources, error) {
 
 	return rep, nil
 }
+
+func GetResources(endpointId int, version int) (*Resources, error) {
+	return defaultClient.GetResources(endpointId, version)
+}
@@ -1,6 +1,7 @@
 package apiendpoints
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
@@ -11,19 +12,37 @@ var (
 	// Config contains the Akamai OPEN Edgegrid API credentials
 	// for automatic signing of requests
 	Config edgegrid.Config
+
+	// defaultClient is used by the package-level functions and methods, with Config
+	defaultClient = New(client.NewLiveSession(&Config))
 )
 
+// Client is a API Endpoint Definition client bound to a client.Session
+type Client struct {
+	session *client.Session
+}
+
+// New creates a new API Endpoint Definition Client using session
+func New(session *client.Session) *Client {
+	return &Client{session: session}
+}
+
+// WithContext returns a copy of the Client making its requests with ctx
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return New(c.session.WithContext(ctx))
+}
+
 // Init sets the CCU edgegrid Config
 func Init(config edgegrid.Config) {
 	Config = config
 }
 
-func call(req *http.Request, err error) (*Endpoint, error) {
+func (c *Client) call(req *http.Request, err error) (*Endpoint, error) {
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
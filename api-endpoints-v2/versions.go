@@ -49,9 +49,8 @@ type ListVersionsOptions struct {
 	EndpointId int
 }
 
-func ListVersions(options *ListVersionsOptions) (*Versions, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) ListVersions(options *ListVersionsOptions) (*Versions, error) {
+	req, err := c.session.NewJSONRequest(
 		"GET",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions",
@@ -64,7 +63,7 @@ func ListVersions(options *ListVersionsOptions) (*Versions, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if client.IsError(res) {
 		return nil, client.NewAPIError(res)
@@ -78,12 +77,16 @@ func ListVersions(options *ListVersionsOptions) (*Versions, error) {
 	return rep, nil
 }
 
+func ListVersions(options *ListVersionsOptions) (*Versions, error) {
+	return defaultClient.ListVersions(options)
+}
+
 type GetVersionOptions struct {
 	EndpointId int
 	Version    int
 }
 
-func GetVersion(options *GetVersionOptions) (*Endpoint, error) {
+func (c *Client) GetVersion(options *GetVersionOptions) (*Endpoint, error) {
 	if options.Version == 0 {
 		versions, err := ListVersions(&ListVersionsOptions{EndpointId: options.EndpointId})
 		if err != nil {
@@ -95,8 +98,7 @@ func GetVersion(options *GetVersionOptions) (*Endpoint, error) {
 		options.Version = v.VersionNumber
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"GET",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions/%d/resources-detail",
@@ -106,12 +108,15 @@ func GetVersion(options *GetVersionOptions) (*Endpoint, error) {
 		nil,
 	)
 
-	return call(req, err)
+	return c.call(req, err)
 }
 
-func ModifyVersion(endpoint *Endpoint) (*Endpoint, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func GetVersion(options *GetVersionOptions) (*Endpoint, error) {
+	return defaultClient.GetVersion(options)
+}
+
+func (c *Client) ModifyVersion(endpoint *Endpoint) (*Endpoint, error) {
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions/%d",
@@ -121,7 +126,11 @@ func ModifyVersion(endpoint *Endpoint) (*Endpoint, error) {
 		endpoint,
 	)
 
-	return call(req, err)
+	return c.call(req, err)
+}
+
+func ModifyVersion(endpoint *Endpoint) (*Endpoint, error) {
+	return defaultClient.ModifyVersion(endpoint)
 }
 
 type CloneVersionOptions struct {
@@ -129,9 +138,8 @@ type CloneVersionOptions struct {
 	Version    int
 }
 
-func CloneVersion(options *CloneVersionOptions) (*Endpoint, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) CloneVersion(options *CloneVersionOptions) (*Endpoint, error) {
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions/%d/cloneVersion",
@@ -141,7 +149,11 @@ func CloneVersion(options *CloneVersionOptions) (*Endpoint, error) {
 		options,
 	)
 
-	return call(req, err)
+	return c.call(req, err)
+}
+
+func CloneVersion(options *CloneVersionOptions) (*Endpoint, error) {
+	return defaultClient.CloneVersion(options)
 }
 
 type RemoveVersionOptions struct {
@@ -149,9 +161,8 @@ type RemoveVersionOptions struct {
 	VersionNumber int
 }
 
-func RemoveVersion(options *RemoveVersionOptions) (*Endpoint, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) RemoveVersion(options *RemoveVersionOptions) (*Endpoint, error) {
+	req, err := c.session.NewJSONRequest(
 		"DELETE",
 		fmt.Sprintf(
 			"/api-definitions/v2/endpoints/%d/versions/%d",
@@ -161,5 +172,9 @@ func RemoveVersion(options *RemoveVersionOptions) (*Endpoint, error) {
 		nil,
 	)
 
-	return call(req, err)
+	return c.call(req, err)
+}
+
+func RemoveVersion(options *RemoveVersionOptions) (*Endpoint, error) {
+	return defaultClient.RemoveVersion(options)
 }
@@ -21,9 +21,8 @@ type Collection struct {
 	Quota       Quota    `json:"quota,omitempty"`
 }
 
-func ListCollections() (*Collections, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) ListCollections() (*Collections, error) {
+	req, err := c.session.NewJSONRequest(
 		"GET",
 		"/apikey-manager-api/v1/collections",
 		nil,
@@ -33,7 +32,7 @@ func ListCollections() (*Collections, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -51,6 +50,10 @@ func ListCollections() (*Collections, error) {
 	return rep, nil
 }
 
+func ListCollections() (*Collections, error) {
+	return defaultClient.ListCollections()
+}
+
 type CreateCollectionOptions struct {
 	ContractId  string `json:"contractId,omitempty"`
 	GroupId     int    `json:"groupId,omitempty"`
@@ -58,9 +61,8 @@ type CreateCollectionOptions struct {
 	Description string `json:"description,omitempty"`
 }
 
-func CreateCollection(options *CreateCollectionOptions) (*Collection, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) CreateCollection(options *CreateCollectionOptions) (*Collection, error) {
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/apikey-manager-api/v1/collections",
 		options,
@@ -70,7 +72,7 @@ func CreateCollection(options *CreateCollectionOptions) (*Collection, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -88,9 +90,12 @@ func CreateCollection(options *CreateCollectionOptions) (*Collection, error) {
 	return rep, nil
 }
 
-func GetCollection(collectionId int) (*Collection, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func CreateCollection(options *CreateCollectionOptions) (*Collection, error) {
+	return defaultClient.CreateCollection(options)
+}
+
+func (c *Client) GetCollection(collectionId int) (*Collection, error) {
+	req, err := c.session.NewJSONRequest(
 		"GET",
 		fmt.Sprintf("/apikey-manager-api/v1/collections/%d", collectionId),
 		nil,
@@ -100,7 +105,7 @@ func GetCollection(collectionId int) (*Collection, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -118,16 +123,19 @@ func GetCollection(collectionId int) (*Collection, error) {
 	return rep, nil
 }
 
-func CollectionAclAllow(collectionId int, acl []string) (*Collection, error) {
-	collection, err := GetCollection(collectionId)
+func GetCollection(collectionId int) (*Collection, error) {
+	return defaultClient.GetCollection(collectionId)
+}
+
+func (c *Client) CollectionAclAllow(collectionId int, acl []string) (*Collection, error) {
+	collection, err := c.GetCollection(collectionId)
 	if err != nil {
 		return collection, err
 	}
 
 	acl = append(acl, collection.GrantedACL...)
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/apikey-manager-api/v1/collections/%d/acl", collectionId),
 		acl,
@@ -137,7 +145,7 @@ func CollectionAclAllow(collectionId int, acl []string) (*Collection, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -155,8 +163,12 @@ func CollectionAclAllow(collectionId int, acl []string) (*Collection, error) {
 	return rep, nil
 }
 
-func CollectionAclDeny(collectionId int, acl []string) (*Collection, error) {
-	collection, err := GetCollection(collectionId)
+func CollectionAclAllow(collectionId int, acl []string) (*Collection, error) {
+	return defaultClient.CollectionAclAllow(collectionId, acl)
+}
+
+func (c *Client) CollectionAclDeny(collectionId int, acl []string) (*Collection, error) {
+	collection, err := c.GetCollection(collectionId)
 	if err != nil {
 		return collection, err
 	}
@@ -172,8 +184,7 @@ func CollectionAclDeny(collectionId int, acl []string) (*Collection, error) {
 		}
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/apikey-manager-api/v1/collections/%d/acl", collectionId),
 		collection.GrantedACL,
@@ -183,7 +194,7 @@ func CollectionAclDeny(collectionId int, acl []string) (*Collection, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -201,6 +212,10 @@ func CollectionAclDeny(collectionId int, acl []string) (*Collection, error) {
 	return rep, nil
 }
 
+func CollectionAclDeny(collectionId int, acl []string) (*Collection, error) {
+	return defaultClient.CollectionAclDeny(collectionId, acl)
+}
+
 type Quota struct {
 	Enabled  bool   `json:"enabled,omitempty"`
 	Value    int    `json:"value,omitempty"`
@@ -215,15 +230,14 @@ type Quota struct {
 	} `json:"headers,omitempty"`
 }
 
-func CollectionSetQuota(collectionId int, value int) (*Collection, error) {
-	collection, err := GetCollection(collectionId)
+func (c *Client) CollectionSetQuota(collectionId int, value int) (*Collection, error) {
+	collection, err := c.GetCollection(collectionId)
 	if err != nil {
 		return collection, err
 	}
 
 	collection.Quota.Value = value
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/apikey-manager-api/v1/collections/%d/quota", collectionId),
 		collection.Quota,
@@ -233,7 +247,7 @@ func CollectionSetQuota(collectionId int, value int) (*Collection, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -250,3 +264,7 @@ func CollectionSetQuota(collectionId int, value int) (*Collection, error) {
 
 	return rep, nil
 }
+
+func CollectionSetQuota(collectionId int, value int) (*Collection, error) {
+	return defaultClient.CollectionSetQuota(collectionId, value)
+}
@@ -2,7 +2,9 @@ package apikeymanager
 
 import (
 	"encoding/json"
+	"io"
 	"io/ioutil"
+	"os"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 )
@@ -36,9 +38,8 @@ type CreateKey struct {
 	Mode         string   `json:"mode,omitempty"`
 }
 
-func CollectionAddKey(collectionId int, name, value string) (*Key, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) CollectionAddKey(collectionId int, name, value string) (*Key, error) {
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/apikey-manager-api/v1/keys",
 		&CreateKey{
@@ -53,7 +54,7 @@ func CollectionAddKey(collectionId int, name, value string) (*Key, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -71,24 +72,44 @@ func CollectionAddKey(collectionId int, name, value string) (*Key, error) {
 	return rep, nil
 }
 
+func CollectionAddKey(collectionId int, name, value string) (*Key, error) {
+	return defaultClient.CollectionAddKey(collectionId, name, value)
+}
+
 type ImportKey struct {
 	Name         string `json:"name,omitempty"`
 	Content      string `json:"content,omitempty"`
 	CollectionId int    `json:"collectionId,omitempty"`
 }
 
+func (c *Client) CollectionImportKeys(collectionId int, filename string) (*Keys, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return c.CollectionImportKeysFromReader(collectionId, filename, file)
+}
+
 func CollectionImportKeys(collectionId int, filename string) (*Keys, error) {
-	fileContent, err := ioutil.ReadFile(filename)
+	return defaultClient.CollectionImportKeys(collectionId, filename)
+}
+
+// CollectionImportKeysFromReader imports the keys read from content, e.g.
+// generated in memory, as the file name. The API takes the content in a JSON
+// body, so it is read whole before being sent.
+func (c *Client) CollectionImportKeysFromReader(collectionId int, name string, content io.Reader) (*Keys, error) {
+	fileContent, err := ioutil.ReadAll(content)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/apikey-manager-api/v1/keys/import",
 		&ImportKey{
-			Name:         filename,
+			Name:         name,
 			CollectionId: collectionId,
 			Content:      string(fileContent),
 		},
@@ -98,7 +119,7 @@ func CollectionImportKeys(collectionId int, filename string) (*Keys, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -114,13 +135,16 @@ func CollectionImportKeys(collectionId int, filename string) (*Keys, error) {
 	return rep, err
 }
 
+func CollectionImportKeysFromReader(collectionId int, name string, content io.Reader) (*Keys, error) {
+	return defaultClient.CollectionImportKeysFromReader(collectionId, name, content)
+}
+
 type RevokeKeys struct {
 	Keys Keys `json:"keys,omitempty"`
 }
 
-func RevokeKey(key int) (*Key, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) RevokeKey(key int) (*Key, error) {
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/apikey-manager-api/v1/keys/revoke",
 		&RevokeKeys{
@@ -132,7 +156,7 @@ func RevokeKey(key int) (*Key, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -144,3 +168,7 @@ func RevokeKey(key int) (*Key, error) {
 
 	return &Key{}, nil
 }
+
+func RevokeKey(key int) (*Key, error) {
+	return defaultClient.RevokeKey(key)
+}
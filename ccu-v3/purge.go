@@ -30,14 +30,24 @@ func NewPurge(objects []string) *Purge {
 }
 
 func (p *Purge) Invalidate(purgeByType PurgeTypeValue, network NetworkValue) (*PurgeResponse, error) {
-	return p.purge("invalidate", purgeByType, network)
+	return defaultClient.Invalidate(p, purgeByType, network)
 }
 
 func (p *Purge) Delete(purgeByType PurgeTypeValue, network NetworkValue) (*PurgeResponse, error) {
-	return p.purge("delete", purgeByType, network)
+	return defaultClient.Delete(p, purgeByType, network)
 }
 
-func (p *Purge) purge(purgeMethod string, purgeByType PurgeTypeValue, network NetworkValue) (*PurgeResponse, error) {
+// Invalidate marks the objects of p as invalid on network
+func (c *Client) Invalidate(p *Purge, purgeByType PurgeTypeValue, network NetworkValue) (*PurgeResponse, error) {
+	return c.purge(p, "invalidate", purgeByType, network)
+}
+
+// Delete removes the objects of p from network
+func (c *Client) Delete(p *Purge, purgeByType PurgeTypeValue, network NetworkValue) (*PurgeResponse, error) {
+	return c.purge(p, "delete", purgeByType, network)
+}
+
+func (c *Client) purge(p *Purge, purgeMethod string, purgeByType PurgeTypeValue, network NetworkValue) (*PurgeResponse, error) {
 	if len(p.Objects) == 0 {
 		return nil, errors.New("one of more purge objects must be defined")
 	}
@@ -49,12 +59,12 @@ func (p *Purge) purge(purgeMethod string, purgeByType PurgeTypeValue, network Ne
 		network,
 	)
 
-	req, err := client.NewJSONRequest(Config, "POST", url, p)
+	req, err := c.session.NewJSONRequest("POST", url, p)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
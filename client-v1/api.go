@@ -7,6 +7,28 @@ import (
 // Resource is the "base" type for all API resources
 type Resource struct {
 	Complete chan bool `json:"-"`
+
+	session *Session
+}
+
+// Session returns the Session the resource is bound to, nil if none
+func (resource *Resource) Session() *Session {
+	return resource.session
+}
+
+// SetSession binds the resource to session, which is then used for the
+// API calls made by the resource
+func (resource *Resource) SetSession(session *Session) {
+	resource.session = session
+}
+
+// SetParent binds the resource to the Session of parent, if it is a resource
+//
+// See: jsonhooks-v1/jsonhooks.UnmarshalRecursive()
+func (resource *Resource) SetParent(parent interface{}) {
+	if parent, ok := parent.(interface{ Session() *Session }); ok {
+		resource.SetSession(parent.Session())
+	}
 }
 
 // Init initializes the Complete channel, if it is necessary
@@ -19,8 +41,7 @@ func (resource *Resource) Init() {
 // PostUnmarshalJSON is a default implementation of the
 // PostUnmarshalJSON hook that simply calls Init() and
 // sends true to the Complete channel. This is overridden
-// in many resources, in particular those that represent
-// collections, and have to initialize sub-resources also.
+// in resources that have further state to set up.
 func (resource *Resource) PostUnmarshalJSON() error {
 	resource.Init()
 	resource.Complete <- true
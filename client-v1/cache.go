@@ -0,0 +1,281 @@
+package client
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ResponseCache caches the responses to the GET requests made by Do and
+// Session.Do, so that reading the same resource again costs at most a
+// conditional request.
+//
+// Responses are keyed by method and URL, which holds the API host of the
+// account and its accountSwitchKey, if any. A response is served from the
+// cache for its TTL, the TTL of the cache unless set for the request with
+// CacheFor. Past it, a response with an ETag is revalidated with an
+// If-None-Match request, and served again if the API answers 304 Not
+// Modified. Only 200 OK responses with an ETag or a TTL are stored, and the
+// requests with their own conditional headers bypass the cache.
+//
+// A POST, PUT, PATCH or DELETE request invalidates the responses cached for
+// the same host whose path is the path of the request, one of its parents or
+// one of its children, e.g. a POST to /papi/v1/cpcodes invalidates GET
+// /papi/v1/cpcodes?contractId=ctr_1&groupId=grp_1.
+type ResponseCache struct {
+	// TTL is the time a response is served without revalidation. With 0,
+	// responses are revalidated every time and only stored if they have an ETag.
+	TTL time.Duration
+
+	// MaxEntries is the number of responses kept, the least recently used
+	// ones being evicted first. 0 means no limit.
+	MaxEntries int
+
+	// MaxBytes is the total size of the response bodies kept. 0 means no limit.
+	MaxBytes int64
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int64
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	key     string
+	host    string
+	path    string
+	header  http.Header
+	body    []byte
+	etag    string
+	expires time.Time
+}
+
+type cacheTTLKey struct{}
+
+// NewResponseCache creates a new ResponseCache revalidating every response,
+// keeping up to 1000 responses and 32 MiB of bodies
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{
+		MaxEntries: 1000,
+		MaxBytes:   32 << 20,
+	}
+}
+
+// CacheFor returns a copy of req whose response may be served from the cache
+// for ttl without revalidation, e.g. for lists which seldom change
+func CacheFor(req *http.Request, ttl time.Duration) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), cacheTTLKey{}, ttl))
+}
+
+// Len returns the number of responses in the cache
+func (c *ResponseCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Purge removes all the responses from the cache
+func (c *ResponseCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries, c.lru, c.size = nil, nil, 0
+}
+
+// Invalidate removes the responses cached for the host of u whose path is
+// the path of u, one of its parents or one of its children
+func (c *ResponseCache) Invalidate(u *url.URL) {
+	path := strings.TrimSuffix(u.Path, "/")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, element := range c.entries {
+		entry := element.Value.(*cacheEntry)
+		if entry.host == u.Host && (pathWithin(entry.path, path) || pathWithin(path, entry.path)) {
+			c.remove(element)
+		}
+	}
+}
+
+// cache returns next, serving the GET requests from the cache when possible
+func (c *ResponseCache) cache(next Handler) Handler {
+	if c == nil {
+		return next
+	}
+	return func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodHead, http.MethodOptions:
+			return next(req)
+		default:
+			res, err := next(req)
+			c.Invalidate(req.URL)
+			return res, err
+		}
+		if conditional(req) {
+			return next(req)
+		}
+
+		key := req.Method + " " + req.URL.String()
+		entry, found := c.get(key)
+		if found {
+			if c.clock().Before(entry.expires) {
+				return entry.response(req), nil
+			}
+			if entry.etag != "" {
+				revalidation := req.Clone(req.Context())
+				revalidation.Header.Set("If-None-Match", entry.etag)
+				req = revalidation
+			}
+		}
+
+		res, err := next(req)
+		if err != nil {
+			return res, err
+		}
+
+		switch {
+		case res.StatusCode == http.StatusNotModified && found && entry.etag != "":
+			io.Copy(ioutil.Discard, res.Body)
+			res.Body.Close()
+			c.refresh(key, c.ttl(req))
+			return entry.response(req), nil
+		case res.StatusCode == http.StatusOK:
+			return c.store(key, req, res)
+		case found:
+			c.delete(key)
+		}
+		return res, nil
+	}
+}
+
+// store caches res if it can be revalidated or has a TTL, returning a copy
+// of res whose body can be read
+func (c *ResponseCache) store(key string, req *http.Request, res *http.Response) (*http.Response, error) {
+	etag := res.Header.Get("ETag")
+	ttl := c.ttl(req)
+	if (etag == "" && ttl <= 0) || strings.Contains(res.Header.Get("Cache-Control"), "no-store") {
+		return res, nil
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if c.MaxBytes > 0 && int64(len(body)) > c.MaxBytes {
+		return res, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+		c.lru = list.New()
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{
+		key:     key,
+		host:    req.URL.Host,
+		path:    strings.TrimSuffix(req.URL.Path, "/"),
+		header:  res.Header.Clone(),
+		body:    body,
+		etag:    etag,
+		expires: c.clock().Add(ttl),
+	})
+	c.size += int64(len(body))
+	for (c.MaxEntries > 0 && len(c.entries) > c.MaxEntries) || (c.MaxBytes > 0 && c.size > c.MaxBytes) {
+		c.remove(c.lru.Back())
+	}
+	return res, nil
+}
+
+// get returns a copy of the entry cached for key, if any
+func (c *ResponseCache) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	c.lru.MoveToFront(element)
+	return *element.Value.(*cacheEntry), true
+}
+
+// refresh serves the entry cached for key for ttl more
+func (c *ResponseCache) refresh(key string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cacheEntry).expires = c.clock().Add(ttl)
+	}
+}
+
+func (c *ResponseCache) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+}
+
+// remove removes element from the cache. The caller holds c.mu.
+func (c *ResponseCache) remove(element *list.Element) {
+	entry := c.lru.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= int64(len(entry.body))
+}
+
+func (c *ResponseCache) ttl(req *http.Request) time.Duration {
+	if ttl, ok := req.Context().Value(cacheTTLKey{}).(time.Duration); ok {
+		return ttl
+	}
+	return c.TTL
+}
+
+func (c *ResponseCache) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// response returns a 200 OK response to req with the cached header and body
+func (e cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// conditional tells whether req has its own conditional headers
+func conditional(req *http.Request) bool {
+	for _, name := range []string{"If-None-Match", "If-Match", "If-Modified-Since", "If-Unmodified-Since"} {
+		if req.Header.Get(name) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// pathWithin tells whether path is parent or the path of one of its descendants
+func pathWithin(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, parent+"/")
+}
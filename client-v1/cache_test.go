@@ -0,0 +1,188 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheServer serves a version of each resource, bumped by a POST, with an
+// ETag if etags is set, counting the requests and the 304 responses
+type cacheServer struct {
+	mu          sync.Mutex
+	etags       bool
+	versions    map[string]int
+	requests    int
+	notModified int
+}
+
+func (s *cacheServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	if r.Method == http.MethodPost {
+		s.versions[r.URL.Path]++
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	body := fmt.Sprintf("%s?%s v%d", r.URL.Path, r.URL.RawQuery, s.versions[r.URL.Path])
+	if s.etags {
+		etag := fmt.Sprintf(`"%d"`, s.versions[r.URL.Path])
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			s.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	w.Write([]byte(body))
+}
+
+func newCacheSession(t *testing.T, etags bool) (*Session, *cacheServer, func()) {
+	handler := &cacheServer{etags: etags, versions: map[string]int{}}
+	server := httptest.NewTLSServer(handler)
+
+	session := NewSession(edgegrid.Config{
+		Host:         server.URL,
+		ClientToken:  "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		MaxBody:      2048,
+	})
+	session.HTTPClient = server.Client()
+	session.Cache = NewResponseCache()
+	return session, handler, server.Close
+}
+
+func cachedGet(t *testing.T, session *Session, path string, ttl time.Duration) string {
+	req, err := session.NewRequest("GET", path, nil)
+	require.NoError(t, err)
+	if ttl > 0 {
+		req = CacheFor(req, ttl)
+	}
+	res, err := session.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestResponseCache_OptIn(t *testing.T) {
+	session, handler, closeServer := newCacheSession(t, true)
+	defer closeServer()
+	session.Cache = nil
+
+	cachedGet(t, session, "/papi/v1/groups", time.Minute)
+	cachedGet(t, session, "/papi/v1/groups", time.Minute)
+	assert.Equal(t, 2, handler.requests)
+	assert.Equal(t, 0, handler.notModified)
+}
+
+func TestResponseCache_Revalidates(t *testing.T) {
+	session, server, closeServer := newCacheSession(t, true)
+	defer closeServer()
+
+	assert.Equal(t, "/papi/v1/groups? v0", cachedGet(t, session, "/papi/v1/groups", 0))
+	assert.Equal(t, "/papi/v1/groups? v0", cachedGet(t, session, "/papi/v1/groups", 0))
+	assert.Equal(t, 2, server.requests)
+	assert.Equal(t, 1, server.notModified)
+
+	// A request with its own conditional header bypasses the cache
+	req, _ := session.NewRequest("GET", "/papi/v1/groups", nil)
+	req.Header.Set("If-None-Match", `"0"`)
+	res, err := session.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotModified, res.StatusCode)
+}
+
+func TestResponseCache_TTL(t *testing.T) {
+	session, server, closeServer := newCacheSession(t, false)
+	defer closeServer()
+	now := time.Now()
+	session.Cache.now = func() time.Time { return now }
+
+	// Without ETag nor TTL, nothing is cached
+	cachedGet(t, session, "/papi/v1/contracts", 0)
+	cachedGet(t, session, "/papi/v1/contracts", 0)
+	assert.Equal(t, 2, server.requests)
+	assert.Equal(t, 0, session.Cache.Len())
+
+	cachedGet(t, session, "/papi/v1/cpcodes?contractId=ctr_1", time.Minute)
+	cachedGet(t, session, "/papi/v1/cpcodes?contractId=ctr_1", time.Minute)
+	assert.Equal(t, 3, server.requests)
+
+	// Other query parameters are another resource
+	assert.Equal(t, "/papi/v1/cpcodes?contractId=ctr_2 v0", cachedGet(t, session, "/papi/v1/cpcodes?contractId=ctr_2", time.Minute))
+	assert.Equal(t, 4, server.requests)
+
+	now = now.Add(2 * time.Minute)
+	cachedGet(t, session, "/papi/v1/cpcodes?contractId=ctr_1", time.Minute)
+	assert.Equal(t, 5, server.requests)
+}
+
+func TestResponseCache_AccountSwitchKey(t *testing.T) {
+	session, server, closeServer := newCacheSession(t, false)
+	defer closeServer()
+
+	cachedGet(t, session, "/papi/v1/groups", time.Minute)
+	switched := NewSession(session.Config)
+	switched.Config.AccountKey = "1-ABCDE"
+	switched.HTTPClient, switched.Cache = session.HTTPClient, session.Cache
+	assert.Contains(t, cachedGet(t, switched, "/papi/v1/groups", time.Minute), "accountSwitchKey=1-ABCDE")
+	assert.Equal(t, 2, server.requests)
+}
+
+func TestResponseCache_InvalidatedByMutations(t *testing.T) {
+	session, server, closeServer := newCacheSession(t, false)
+	defer closeServer()
+
+	cachedGet(t, session, "/papi/v1/cpcodes?contractId=ctr_1", time.Minute)
+	cachedGet(t, session, "/papi/v1/cpcodes/cpc_1", time.Minute)
+	cachedGet(t, session, "/papi/v1/groups", time.Minute)
+	assert.Equal(t, 3, session.Cache.Len())
+
+	req, _ := session.NewJSONRequest("POST", "/papi/v1/cpcodes?contractId=ctr_1", map[string]string{"cpcodeName": "example"})
+	_, err := session.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, session.Cache.Len())
+
+	assert.Equal(t, "/papi/v1/cpcodes?contractId=ctr_1 v1", cachedGet(t, session, "/papi/v1/cpcodes?contractId=ctr_1", time.Minute))
+	cachedGet(t, session, "/papi/v1/groups", time.Minute)
+	assert.Equal(t, 5, server.requests)
+}
+
+func TestResponseCache_Limits(t *testing.T) {
+	session, _, closeServer := newCacheSession(t, false)
+	defer closeServer()
+	session.Cache.MaxEntries = 2
+
+	cachedGet(t, session, "/papi/v1/groups", time.Minute)
+	cachedGet(t, session, "/papi/v1/contracts", time.Minute)
+	cachedGet(t, session, "/papi/v1/groups", time.Minute)
+	cachedGet(t, session, "/papi/v1/products", time.Minute)
+	assert.Equal(t, 2, session.Cache.Len())
+	_, found := session.Cache.get("GET " + session.Config.Host + "/papi/v1/groups")
+	assert.True(t, found)
+	_, found = session.Cache.get("GET " + session.Config.Host + "/papi/v1/contracts")
+	assert.False(t, found)
+
+	// A body larger than MaxBytes is not cached
+	session.Cache.MaxBytes = 10
+	cachedGet(t, session, "/papi/v1/cpcodes", time.Minute)
+	_, found = session.Cache.get("GET " + session.Config.Host + "/papi/v1/cpcodes")
+	assert.False(t, found)
+
+	session.Cache.Purge()
+	assert.Equal(t, 0, session.Cache.Len())
+}
@@ -0,0 +1,254 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"os"
+	"sync"
+)
+
+// RecorderMode tells whether a Recorder records or replays the interactions
+type RecorderMode int
+
+const (
+	// ModeRecord sends the requests and records them with their responses
+	ModeRecord RecorderMode = iota
+
+	// ModeReplay serves the recorded responses without network access
+	ModeReplay
+)
+
+// Cassette is the JSON document holding the interactions recorded by a Recorder
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request as stored in a Cassette, without its volatile headers
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response as stored in a Cassette, without its volatile headers
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// DefaultScrubbedHeaders are the headers left out of the cassettes by default:
+// the Authorization header, which holds the client token, timestamp, nonce and
+// signature of the request, and other headers changing with every call
+var DefaultScrubbedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Date",
+	"Expires",
+	"X-Akamai-Request-Id",
+	"X-Trace-Id",
+}
+
+// Recorder records the signed requests sent by a Session, or by Do, with their
+// responses to a cassette file, and replays them in tests without network
+// access. It is used as signed middleware:
+//
+//	recorder, err := client.NewRecorder("testdata/activation.json", client.ModeReplay)
+//	session.UseSigned(recorder.Middleware)
+//	...
+//	err = recorder.Save()
+//
+// A request is replayed with the first recorded interaction not replayed yet
+// whose request matches it, so a polled URL gets its responses in the recorded order.
+type Recorder struct {
+	// Path is the cassette file
+	Path string
+
+	// Mode tells whether to record or replay
+	Mode RecorderMode
+
+	// ScrubHeaders lists the request and response headers which are not
+	// recorded, matched case-insensitively. NewRecorder sets it to DefaultScrubbedHeaders.
+	ScrubHeaders []string
+
+	// Match tells whether a request matches a recorded one. If nil, the
+	// method, path, query and body must be equal, the host is not compared.
+	Match func(req *http.Request, body string, recorded RecordedRequest) bool
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// NewRecorder creates a new Recorder for the cassette file path. In ModeReplay
+// the cassette is loaded from path.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{Path: path, Mode: mode, ScrubHeaders: DefaultScrubbedHeaders}
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %s", path, err)
+	}
+	r.replayed = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Middleware records or replays the requests, to be added with UseSigned
+func (r *Recorder) Middleware(next Handler) Handler {
+	return func(req *http.Request) (*http.Response, error) {
+		body, err := readBody(req)
+		if err != nil {
+			return nil, err
+		}
+		if r.Mode == ModeReplay {
+			return r.replay(req, body)
+		}
+
+		res, err := next(req)
+		if err != nil {
+			return nil, err
+		}
+		return r.record(req, body, res)
+	}
+}
+
+// Interactions returns the interactions recorded or loaded so far
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.cassette.Interactions...)
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.Mode == ModeReplay {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.Path, append(data, '\n'), os.FileMode(0644))
+}
+
+func (r *Recorder) record(req *http.Request, body string, res *http.Response) (*http.Response, error) {
+	resBody, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: r.scrub(req.Header),
+			Body:   body,
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     r.scrub(res.Header),
+			Body:       string(resBody),
+		},
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !r.match(req, body, interaction.Request) {
+			continue
+		}
+		r.replayed[i] = true
+
+		recorded := interaction.Response
+		header := http.Header{}
+		for key, values := range recorded.Header {
+			header[key] = append([]string(nil), values...)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(recorded.Body))),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction for %s %s in %s", req.Method, req.URL, r.Path)
+}
+
+func (r *Recorder) match(req *http.Request, body string, recorded RecordedRequest) bool {
+	if r.Match != nil {
+		return r.Match(req, body, recorded)
+	}
+	if req.Method != recorded.Method || body != recorded.Body {
+		return false
+	}
+	u, err := req.URL.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	return u.Path == req.URL.Path && u.Query().Encode() == req.URL.Query().Encode()
+}
+
+// scrub returns a copy of header without the ScrubHeaders
+func (r *Recorder) scrub(header http.Header) http.Header {
+	scrubbed := http.Header{}
+	for key, values := range header {
+		scrubbed[key] = append([]string(nil), values...)
+	}
+	for _, name := range r.ScrubHeaders {
+		delete(scrubbed, textproto.CanonicalMIMEHeaderKey(name))
+	}
+	if len(scrubbed) == 0 {
+		return nil
+	}
+	return scrubbed
+}
+
+// readBody returns the body of req, leaving it readable again
+func readBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return string(body), nil
+}
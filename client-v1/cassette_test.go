@@ -0,0 +1,70 @@
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "activation.json")
+
+	// Record: the responses come from the server
+	session, requests, closeServer := retryServer(nil)
+	recorder, err := NewRecorder(path, ModeRecord)
+	assert.NoError(t, err)
+	session.UseSigned(recorder.Middleware)
+
+	req, _ := session.NewJSONRequest("POST", "/papi/v1/properties/prp_1/activations", map[string]string{"network": "STAGING"})
+	res, err := session.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	req, _ = session.NewRequest("GET", "/papi/v1/properties/prp_1/activations?contractId=ctr_1", nil)
+	_, err = session.Do(req)
+	assert.NoError(t, err)
+	closeServer()
+
+	assert.Len(t, *requests, 2)
+	assert.NoError(t, recorder.Save())
+	cassette, _ := ioutil.ReadFile(path)
+	assert.NotContains(t, string(cassette), "EG1-HMAC-SHA256")
+	assert.Contains(t, string(cassette), `"body": "{\"network\":\"STAGING\"}"`)
+
+	// Replay: the server is gone, the responses come from the cassette
+	replayed, _, closeServer := retryServer(nil)
+	closeServer()
+	recorder, err = NewRecorder(path, ModeReplay)
+	assert.NoError(t, err)
+	replayed.UseSigned(recorder.Middleware)
+
+	req, _ = replayed.NewJSONRequest("POST", "/papi/v1/properties/prp_1/activations", map[string]string{"network": "STAGING"})
+	res, err = replayed.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	req, _ = replayed.NewRequest("GET", "/papi/v1/properties/prp_1/activations?contractId=ctr_1", nil)
+	_, err = replayed.Do(req)
+	assert.NoError(t, err)
+
+	// Each interaction is replayed once
+	_, err = replayed.Do(req)
+	assert.Error(t, err)
+}
+
+func TestRecorder_Scrub(t *testing.T) {
+	recorder := &Recorder{ScrubHeaders: DefaultScrubbedHeaders}
+	header := http.Header{}
+	header.Set("Authorization", "EG1-HMAC-SHA256 client_token=xxx;timestamp=20200101T00:00:00+0000;nonce=xxx;signature=xxx")
+	header.Set("Content-Type", "application/json")
+
+	assert.Equal(t, http.Header{"Content-Type": []string{"application/json"}}, recorder.scrub(header))
+	assert.NotEmpty(t, header.Get("Authorization"))
+}
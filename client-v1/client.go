@@ -3,6 +3,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/jsonhooks-v1"
@@ -16,6 +17,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -24,21 +26,32 @@ var (
 	UserAgent = "Akamai-Open-Edgegrid-golang/" + libraryVersion + " golang/" + strings.TrimPrefix(runtime.Version(), "go")
 	// Client is the *http.Client to use
 	Client = http.DefaultClient
-
-	reqLock sync.Mutex
+	// Retry, if set, is the RetryPolicy used by Do and by the Sessions without
+	// one, e.g. NewRetryPolicy(). By default requests are sent once.
+	Retry *RetryPolicy
+	// RateLimit, if set, is the RateLimiter pacing the requests made by Do and
+	// by the Sessions without one, e.g. NewRateLimiter(). By default requests
+	// are not paced.
+	RateLimit *RateLimiter
+	// Cache, if set, is the ResponseCache serving the GET requests made by Do
+	// and by the Sessions without one, e.g. NewResponseCache(). By default
+	// responses are not cached.
+	Cache *ResponseCache
 )
 
 // NewRequest creates an HTTP request that can be sent to Akamai APIs. A relative URL can be provided in path, which will be resolved to the
 // Host specified in Config. If body is specified, it will be sent as the request body.
 func NewRequest(config edgegrid.Config, method, path string, body io.Reader) (*http.Request, error) {
+	return NewRequestWithContext(context.Background(), config, method, path, body)
+}
+
+// NewRequestWithContext creates an HTTP request like NewRequest, sent with ctx
+func NewRequestWithContext(ctx context.Context, config edgegrid.Config, method, path string, body io.Reader) (*http.Request, error) {
 	var (
 		baseURL *url.URL
 		err     error
 	)
 
-	reqLock.Lock()
-	defer reqLock.Unlock()
-
 	if strings.HasPrefix(config.Host, "https://") {
 		baseURL, err = url.Parse(config.Host)
 	} else {
@@ -61,7 +74,7 @@ func NewRequest(config edgegrid.Config, method, path string, body io.Reader) (*h
 		u.RawQuery = q.Encode()
 	}
 
-	req, err := http.NewRequest(method, u.String(), body)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +87,11 @@ func NewRequest(config edgegrid.Config, method, path string, body io.Reader) (*h
 // NewJSONRequest creates an HTTP request that can be sent to the Akamai APIs with a JSON body
 // The JSON body is encoded and the Content-Type/Accept headers are set automatically.
 func NewJSONRequest(config edgegrid.Config, method, path string, body interface{}) (*http.Request, error) {
+	return NewJSONRequestWithContext(context.Background(), config, method, path, body)
+}
+
+// NewJSONRequestWithContext creates an HTTP request with a JSON body like NewJSONRequest, sent with ctx
+func NewJSONRequestWithContext(ctx context.Context, config edgegrid.Config, method, path string, body interface{}) (*http.Request, error) {
 	var req *http.Request
 	var err error
 
@@ -83,9 +101,9 @@ func NewJSONRequest(config edgegrid.Config, method, path string, body interface{
 			return nil, err
 		}
 		buf := bytes.NewReader(jsonBody)
-		req, err = NewRequest(config, method, path, buf)
+		req, err = NewRequestWithContext(ctx, config, method, path, buf)
 	} else {
-		req, err = NewRequest(config, method, path, nil)
+		req, err = NewRequestWithContext(ctx, config, method, path, nil)
 	}
 
 	if err != nil {
@@ -98,8 +116,15 @@ func NewJSONRequest(config edgegrid.Config, method, path string, body interface{
 	return req, nil
 }
 
-// NewMultiPartFormDataRequest creates an HTTP request that uploads a file to the Akamai API
+// NewMultiPartFormDataRequest creates an HTTP request that uploads a file to the Akamai API,
+// in the importFile form field. The file is read in memory; use
+// NewMultiPartFormDataStreamRequest to stream it or to use another field.
 func NewMultiPartFormDataRequest(config edgegrid.Config, uriPath, filePath string, otherFormParams map[string]string) (*http.Request, error) {
+	return NewMultiPartFormDataRequestWithContext(context.Background(), config, uriPath, filePath, otherFormParams)
+}
+
+// NewMultiPartFormDataRequestWithContext creates an HTTP request uploading a file like NewMultiPartFormDataRequest, sent with ctx
+func NewMultiPartFormDataRequestWithContext(ctx context.Context, config edgegrid.Config, uriPath, filePath string, otherFormParams map[string]string) (*http.Request, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, err
@@ -108,7 +133,6 @@ func NewMultiPartFormDataRequest(config edgegrid.Config, uriPath, filePath strin
 
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
-	// TODO: make this field name configurable
 	part, err := writer.CreateFormFile("importFile", filepath.Base(filePath))
 	if err != nil {
 		return nil, err
@@ -123,26 +147,63 @@ func NewMultiPartFormDataRequest(config edgegrid.Config, uriPath, filePath strin
 		return nil, err
 	}
 
-	req, err := NewRequest(config, "POST", uriPath, body)
+	req, err := NewRequestWithContext(ctx, config, "POST", uriPath, body)
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	return req, err
 }
 
 // Do performs a given HTTP Request, signed with the Akamai OPEN Edgegrid
 // Authorization header. An edgegrid.Response or an error is returned.
+//
+// The request is sent by a Session for config, so that redirects are signed
+// and a request rejected because of a skewed local clock is signed again and
+// retried once, as with Session.Do. The clock offset learned is kept for the
+// API host and used by the next calls.
+//
+// When set, Cache serves the GET requests, RateLimit paces the requests and
+// Retry retries the failed ones. Every call goes through the middleware added
+// with Use, and is logged through edgegrid.GetLogger() with the service,
+// operation and status fields.
 func Do(config edgegrid.Config, req *http.Request) (*http.Response, error) {
-	Client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
-		req = edgegrid.AddRequestHeader(config, req)
-		return nil
+	host := strings.TrimPrefix(config.Host, "https://")
+	session := NewSession(config)
+	signer := session.getSigner()
+	if offset, ok := clockOffsets.Load(host); ok {
+		signer.SetClockOffset(offset.(time.Duration))
 	}
 
-	req = edgegrid.AddRequestHeader(config, req)
-	res, err := Client.Do(req)
-	if err != nil {
-		return nil, err
+	res, err := session.Do(req)
+
+	if offset := signer.ClockOffset(); offset != 0 {
+		clockOffsets.Store(host, offset)
+	}
+	return res, err
+}
+
+// clockOffsets holds the clock offset learned by Do for each API host
+var clockOffsets sync.Map
+
+// DoWithContext performs req like Do, with ctx. The request is canceled when
+// ctx is done.
+func DoWithContext(ctx context.Context, config edgegrid.Config, req *http.Request) (*http.Response, error) {
+	return Do(config, req.WithContext(ctx))
+}
+
+// Sleep pauses the current goroutine for d, or until ctx is done, in which
+// case ctx.Err() is returned. It is used by the polling loops.
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
 	}
 
-	return res, nil
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // BodyJSON unmarshals the Response.Body into a given data structure
@@ -1,12 +1,18 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewRequest(t *testing.T) {
@@ -81,3 +87,89 @@ func verifyResponseConfig(t *testing.T, config edgegrid.Config, req *http.Reques
 
 	assert.True(t, strings.Contains(json["headers"].(map[string]interface{})["Authorization"].(string), "local-config"))
 }
+
+func TestDo_Concurrent(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "EG1-HMAC-SHA256 client_token=") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/papi/v1/groups?"+r.URL.RawQuery, http.StatusFound)
+			return
+		}
+		w.Write([]byte(r.URL.RawQuery))
+	}))
+	defer server.Close()
+
+	httpClient := Client
+	Client = server.Client()
+	defer func() { Client = httpClient }()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			config := edgegrid.Config{
+				Host:         server.URL,
+				ClientToken:  fmt.Sprintf("akab-client-token-%d", i),
+				AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+				ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+			}
+			req, err := NewRequest(config, "GET", fmt.Sprintf("/redirect?n=%d", i), nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			res, err := Do(config, req)
+			if assert.NoError(t, err) {
+				assert.Equal(t, http.StatusOK, res.StatusCode)
+				assert.Equal(t, config.ClientToken, strings.Split(strings.Split(res.Request.Header.Get("Authorization"), "client_token=")[1], ";")[0])
+				res.Body.Close()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// The shared Client is left untouched
+	assert.Nil(t, Client.CheckRedirect)
+}
+
+func TestDo_ClockSkew(t *testing.T) {
+	config := edgegrid.Config{
+		ClientToken:  "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		MaxBody:      2048,
+	}
+	serverNow := func() time.Time { return time.Now().Add(time.Hour) }
+	verifier := &edgegrid.Verifier{Config: config, Now: serverNow}
+
+	var attempts int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Date", serverNow().UTC().Format(http.TimeFormat))
+		if err := verifier.Verify(r); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	config.Host = server.URL
+
+	httpClient := Client
+	Client = server.Client()
+	defer func() { Client = httpClient }()
+
+	for _, expected := range []int32{2, 3} {
+		req, err := NewRequest(config, "GET", "/papi/v1/groups", nil)
+		require.NoError(t, err)
+		res, err := Do(config, req)
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		// The offset learned by the first call is reused by the second
+		assert.Equal(t, expected, atomic.LoadInt32(&attempts))
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -50,6 +51,100 @@ func (error APIError) Error() string {
 	return strings.TrimSpace(fmt.Sprintf("API Error: %d %s %s More Info %s\n %s", error.Status, error.Title, error.Detail, error.Type, errorDetails))
 }
 
+// The categories of errors, shared by all services, to handle errors with
+// errors.Is the same way whatever the service:
+//
+//	if errors.Is(err, client.ErrNotFound) {
+//		// create it
+//	}
+//
+// The errors of the API responses are APIError values, or wrap one, so that
+// errors.As gives access to their RFC 7807 problem details.
+var (
+	// ErrNotFound is the category of the errors for missing resources, e.g. 404 responses
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict is the category of the errors for concurrent modifications
+	// and failed preconditions, 409 and 412 responses
+	ErrConflict = errors.New("conflict")
+
+	// ErrRateLimited is the category of the errors for throttled requests, 429 responses
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrValidation is the category of the errors for invalid requests, 400 and 422 responses
+	ErrValidation = errors.New("validation failed")
+
+	// ErrAuth is the category of the errors for unauthenticated or forbidden requests, 401 and 403 responses
+	ErrAuth = errors.New("not authorized")
+
+	// ErrTransient is the category of the errors that may not happen again:
+	// 500, 502, 503 and 504 responses, and network errors such as connection resets
+	ErrTransient = errors.New("transient failure")
+)
+
+// StatusCategory returns the category of the errors for responses with
+// status, or nil if it has none
+func StatusCategory(status int) error {
+	switch status {
+	case http.StatusNotFound, http.StatusGone:
+		return ErrNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return ErrConflict
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrAuth
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return ErrTransient
+	}
+	return nil
+}
+
+// Category returns the category of err, e.g. ErrNotFound, or nil if it has none
+func Category(err error) error {
+	for _, category := range []error{ErrNotFound, ErrConflict, ErrRateLimited, ErrValidation, ErrAuth, ErrTransient} {
+		if errors.Is(err, category) {
+			return category
+		}
+	}
+	if isTransientError(err) {
+		return ErrTransient
+	}
+	return nil
+}
+
+// IsRetryable tells whether the call failing with err may succeed if made
+// again, later: it was rate limited, or failed with a transient error
+func IsRetryable(err error) bool {
+	var retryable interface{ Retryable() bool }
+	if errors.As(err, &retryable) {
+		return retryable.Retryable()
+	}
+	return isTransientError(err)
+}
+
+// Is tells whether the error is of the category target, e.g. ErrNotFound
+func (error APIError) Is(target error) bool {
+	category := StatusCategory(error.status())
+	return category != nil && target == category
+}
+
+// Retryable tells whether the request may succeed if sent again, later
+func (error APIError) Retryable() bool {
+	category := StatusCategory(error.status())
+	return category == ErrRateLimited || category == ErrTransient
+}
+
+// status returns the status of the problem details, or else of the response
+func (error APIError) status() int {
+	if error.Status == 0 && error.Response != nil {
+		return error.Response.StatusCode
+	}
+	return error.Status
+}
+
 // NewAPIError creates a new API error based on a Response,
 // or http.Response-like.
 func NewAPIError(response *http.Response) APIError {
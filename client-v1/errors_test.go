@@ -0,0 +1,69 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func problemResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func TestAPIError_Categories(t *testing.T) {
+	tests := map[int]error{
+		http.StatusNotFound:              ErrNotFound,
+		http.StatusConflict:              ErrConflict,
+		http.StatusPreconditionFailed:    ErrConflict,
+		http.StatusTooManyRequests:       ErrRateLimited,
+		http.StatusBadRequest:            ErrValidation,
+		http.StatusForbidden:             ErrAuth,
+		http.StatusServiceUnavailable:    ErrTransient,
+		http.StatusNotImplemented:        nil,
+		http.StatusRequestEntityTooLarge: nil,
+	}
+	for status, category := range tests {
+		err := NewAPIError(problemResponse(status, fmt.Sprintf(`{"type": "https://problems.luna.akamaiapis.net/x", "title": "Problem", "status": %d}`, status)))
+		assert.Equal(t, category, Category(err), "status %d", status)
+		if category != nil {
+			assert.True(t, errors.Is(err, category), "status %d", status)
+			assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", err), category), "status %d", status)
+		}
+		assert.Equal(t, category == ErrRateLimited || category == ErrTransient, IsRetryable(err), "status %d", status)
+	}
+}
+
+func TestAPIError_As(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", NewAPIError(problemResponse(http.StatusNotFound, "not JSON")))
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrConflict))
+
+	var apiErr APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, http.StatusNotFound, apiErr.Status)
+		assert.Equal(t, "not JSON", apiErr.RawBody)
+	}
+}
+
+func TestCategory_NetworkErrors(t *testing.T) {
+	reset := &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}}
+	assert.Equal(t, ErrTransient, Category(reset))
+	assert.True(t, IsRetryable(reset))
+
+	canceled := &url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled}
+	assert.Nil(t, Category(canceled))
+	assert.False(t, IsRetryable(canceled))
+	assert.Nil(t, Category(nil))
+}
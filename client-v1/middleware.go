@@ -0,0 +1,125 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+)
+
+// Handler sends a request and returns its response, like http.Client.Do
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler to add behavior around the requests, e.g. tracing,
+// metrics or auditing:
+//
+//	func timing(next client.Handler) client.Handler {
+//		return func(req *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			res, err := next(req)
+//			metrics.Observe(req.URL.Path, time.Since(start))
+//			return res, err
+//		}
+//	}
+//
+//	client.Use(timing)
+//
+// Middleware added with Use sees every call once, before the request is paced,
+// signed and retried. Middleware added with UseSigned sees every attempt,
+// redirects included, once signed and just before it is sent: it must not
+// modify the signed parts of the request. Both see the response, or error,
+// returned by next.
+type Middleware func(next Handler) Handler
+
+var (
+	middlewareMu     sync.RWMutex
+	middleware       []Middleware
+	signedMiddleware []Middleware
+)
+
+// Use adds middleware to the calls made by Do and all the Sessions, before signing.
+// Middleware added with Use is run before the middleware of the Sessions.
+func Use(mw ...Middleware) {
+	middlewareMu.Lock()
+	defer middlewareMu.Unlock()
+	middleware = append(middleware[:len(middleware):len(middleware)], mw...)
+}
+
+// UseSigned adds middleware to the attempts made by Do and all the Sessions, after signing
+func UseSigned(mw ...Middleware) {
+	middlewareMu.Lock()
+	defer middlewareMu.Unlock()
+	signedMiddleware = append(signedMiddleware[:len(signedMiddleware):len(signedMiddleware)], mw...)
+}
+
+// globalMiddleware returns the middleware added with Use and UseSigned
+func globalMiddleware() (unsigned []Middleware, signed []Middleware) {
+	middlewareMu.RLock()
+	defer middlewareMu.RUnlock()
+	return middleware, signedMiddleware
+}
+
+// Chain returns h wrapped by middleware, the first one being the outermost
+func Chain(h Handler, middleware ...Middleware) Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+	return h
+}
+
+// RequestHook returns a Middleware calling hook with each request before passing it on
+func RequestHook(hook func(req *http.Request)) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			hook(req)
+			return next(req)
+		}
+	}
+}
+
+// ResponseHook returns a Middleware calling hook with the outcome of each request
+func ResponseHook(hook func(res *http.Response, err error)) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			res, err := next(req)
+			hook(res, err)
+			return res, err
+		}
+	}
+}
+
+// logCalls logs the outcome of every call with the service, operation and status fields
+func logCalls(next Handler) Handler {
+	return func(req *http.Request) (*http.Response, error) {
+		res, err := next(req)
+
+		logger := edgegrid.LoggerFromContext(req.Context())
+		if err != nil {
+			fields := edgegrid.RequestLogFields(req)
+			fields[edgegrid.LogFieldError] = err
+			logger.Error("API request failed", fields)
+			return nil, err
+		}
+
+		logger.Debug("API request completed", edgegrid.ResponseLogFields(res))
+		return res, nil
+	}
+}
+
+// handlerTransport is an http.RoundTripper sending the requests with a Handler
+type handlerTransport Handler
+
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t(req)
+}
+
+// roundTripper returns base, wrapped by the signed middleware if any
+func roundTripper(base http.RoundTripper, signed ...Middleware) http.RoundTripper {
+	if len(signed) == 0 {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return handlerTransport(Chain(base.RoundTrip, signed...))
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tracing returns a Middleware appending name and the Authorization header
+// of each request it sees to calls
+func tracing(name string, calls *[]string) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name+":"+req.Header.Get("Authorization"))
+			return next(req)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	h := Chain(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "handler:")
+		return &http.Response{StatusCode: 200}, nil
+	}, tracing("first", &calls), tracing("second", &calls))
+
+	req, _ := http.NewRequest("GET", "https://example.com", nil)
+	res, err := h(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, []string{"first:", "second:", "handler:"}, calls)
+}
+
+func TestSession_Middleware(t *testing.T) {
+	session, requests, closeServer := retryServer(nil, 503)
+	defer closeServer()
+
+	var calls []string
+	defer func(unsigned, signed []Middleware) {
+		middleware, signedMiddleware = unsigned, signed
+	}(globalMiddleware())
+	Use(tracing("global", &calls))
+	UseSigned(tracing("global-signed", &calls))
+	session.Use(tracing("session", &calls))
+	session.UseSigned(tracing("session-signed", &calls))
+	session.Hooks.BeforeRequest = func(req *http.Request) { calls = append(calls, "hook:"+req.Header.Get("Authorization")) }
+
+	req, _ := session.NewRequest("GET", "/papi/v1/groups", nil)
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	if assert.Len(t, *requests, 2) && assert.Len(t, calls, 7) {
+		// The unsigned middleware sees the call once, the signed middleware every attempt
+		assert.Equal(t, []string{"global:", "session:", "hook:"}, calls[:3])
+		for i, attempt := range []int{3, 5} {
+			authorization := (*requests)[i].authorization
+			assert.Equal(t, "global-signed:"+authorization, calls[attempt])
+			assert.Equal(t, "session-signed:"+authorization, calls[attempt+1])
+		}
+	}
+}
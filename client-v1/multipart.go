@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+)
+
+// NewMultiPartFormDataStreamRequest creates an HTTP request uploading the
+// content of file, named fileName, in the form field fieldName, along with
+// otherFormParams. The body is streamed through an io.Pipe as the request is
+// sent, so the content is never held in memory. Only the first MaxBody bytes
+// are read ahead to sign the request.
+//
+// The body cannot be replayed, so the request is not retried. file is closed
+// once read if it is an io.Closer, or if the request is closed unsent.
+func NewMultiPartFormDataStreamRequest(config edgegrid.Config, uriPath, fieldName, fileName string, file io.Reader, otherFormParams map[string]string) (*http.Request, error) {
+	return NewMultiPartFormDataStreamRequestWithContext(context.Background(), config, uriPath, fieldName, fileName, file, otherFormParams)
+}
+
+// NewMultiPartFormDataStreamRequestWithContext creates an HTTP request streaming
+// an upload like NewMultiPartFormDataStreamRequest, sent with ctx
+func NewMultiPartFormDataStreamRequestWithContext(ctx context.Context, config edgegrid.Config, uriPath, fieldName, fileName string, file io.Reader, otherFormParams map[string]string) (*http.Request, error) {
+	body := newMultipartBody(fieldName, fileName, file, otherFormParams)
+	req, err := NewRequestWithContext(ctx, config, "POST", uriPath, body)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", body.writer.FormDataContentType())
+	return req, nil
+}
+
+// multipartBody is a multipart/form-data request body written by a goroutine
+// started on the first Read
+type multipartBody struct {
+	reader *io.PipeReader
+	pipe   *io.PipeWriter
+	writer *multipart.Writer
+	once   sync.Once
+
+	fieldName string
+	fileName  string
+	file      io.Reader
+	params    map[string]string
+}
+
+func newMultipartBody(fieldName, fileName string, file io.Reader, params map[string]string) *multipartBody {
+	reader, pipe := io.Pipe()
+	return &multipartBody{
+		reader:    reader,
+		pipe:      pipe,
+		writer:    multipart.NewWriter(pipe),
+		fieldName: fieldName,
+		fileName:  fileName,
+		file:      file,
+		params:    params,
+	}
+}
+
+func (b *multipartBody) Read(p []byte) (int, error) {
+	b.once.Do(func() {
+		go b.write()
+	})
+	return b.reader.Read(p)
+}
+
+// Close stops the writing of the body, closing the file if it has not been written
+func (b *multipartBody) Close() error {
+	b.once.Do(b.closeFile)
+	return b.reader.Close()
+}
+
+// write writes the form to the pipe, ending it with the error met, if any
+func (b *multipartBody) write() {
+	err := b.writeForm()
+	b.closeFile()
+	b.pipe.CloseWithError(err)
+}
+
+func (b *multipartBody) writeForm() error {
+	part, err := b.writer.CreateFormFile(b.fieldName, b.fileName)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, b.file); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(b.params))
+	for key := range b.params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := b.writer.WriteField(key, b.params[key]); err != nil {
+			return err
+		}
+	}
+	return b.writer.Close()
+}
+
+func (b *multipartBody) closeFile() {
+	if closer, ok := b.file.(io.Closer); ok {
+		closer.Close()
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestNewMultiPartFormDataStreamRequest(t *testing.T) {
+	content := bytes.Repeat([]byte("openapi: 3.0.0\n"), 100000)
+	var config edgegrid.Config
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := edgegrid.Verify(config, r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		file, header, err := r.FormFile("apiDefinition")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		uploaded, _ := ioutil.ReadAll(file)
+		if header.Filename != "api.yaml" || !bytes.Equal(uploaded, content) || r.FormValue("importFileFormat") != "swagger" {
+			http.Error(w, "unexpected form", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	config = edgegrid.Config{
+		Host:         server.URL,
+		ClientToken:  "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		MaxBody:      2048,
+	}
+	session := NewSession(config)
+	session.HTTPClient = server.Client()
+
+	file := &closeRecorder{Reader: bytes.NewReader(content)}
+	req, err := session.NewMultiPartFormDataStreamRequest("/api-definitions/v2/endpoints/files", "apiDefinition", "api.yaml", file, map[string]string{"importFileFormat": "swagger"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+	assert.Nil(t, req.GetBody)
+
+	res, err := session.Do(req)
+	require.NoError(t, err)
+	body, _ := ioutil.ReadAll(res.Body)
+	assert.Equal(t, http.StatusCreated, res.StatusCode, string(body))
+	assert.True(t, file.closed)
+}
+
+func TestNewMultiPartFormDataStreamRequest_Errors(t *testing.T) {
+	config := edgegrid.Config{Host: "akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net"}
+
+	// A request closed unsent closes the file
+	file := &closeRecorder{Reader: strings.NewReader("content")}
+	req, err := NewMultiPartFormDataStreamRequest(config, "/upload", "importFile", "file.txt", file, nil)
+	require.NoError(t, err)
+	require.NoError(t, req.Body.Close())
+	assert.True(t, file.closed)
+
+	// An error reading the file ends the body with it
+	boom := errors.New("boom")
+	req, err = NewMultiPartFormDataStreamRequest(config, "/upload", "importFile", "file.txt", io.MultiReader(strings.NewReader("content"), &failingReader{boom}), nil)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(req.Body)
+	assert.Equal(t, boom, err)
+}
+
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
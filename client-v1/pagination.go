@@ -0,0 +1,122 @@
+package client
+
+// PageFunc fetches the page number page of a list, starting at 1, returning
+// its items and whether it is the last page
+type PageFunc func(page int) (items []interface{}, last bool, err error)
+
+// Pager walks the items of all the pages of a list, fetching the pages lazily,
+// as Next reaches their end:
+//
+//	for pager.Next() {
+//		item := pager.Value()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+//
+// The service packages wrap Pagers in iterators returning typed values, such
+// as dnsv2.ZoneIterator. A Pager is not safe for concurrent use.
+type Pager struct {
+	// Prefetch, if set before the first call to Next, fetches each page in
+	// the background while the items of the previous one are walked
+	Prefetch bool
+
+	fetch   PageFunc
+	page    int
+	items   []interface{}
+	index   int
+	value   interface{}
+	last    bool
+	err     error
+	pending chan pageResult
+}
+
+type pageResult struct {
+	items []interface{}
+	last  bool
+	err   error
+}
+
+// NewPager creates a new Pager walking the pages returned by fetch
+func NewPager(fetch PageFunc) *Pager {
+	return &Pager{fetch: fetch}
+}
+
+// Next advances to the next item, fetching the next page if needed. It
+// returns false when all the items are walked, or when fetching a page fails.
+func (p *Pager) Next() bool {
+	for p.index >= len(p.items) {
+		if p.last || p.err != nil {
+			p.value = nil
+			return false
+		}
+		p.load()
+	}
+
+	p.value = p.items[p.index]
+	p.index++
+	return true
+}
+
+// Value returns the current item
+func (p *Pager) Value() interface{} {
+	return p.value
+}
+
+// Err returns the error which stopped Next, if any
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Page returns the number of the last page fetched, 0 before the first call to Next
+func (p *Pager) Page() int {
+	return p.page
+}
+
+// ForEach calls fn with each item, stopping at the first error returned by fn
+// or by the fetching of a page
+func (p *Pager) ForEach(fn func(item interface{}) error) error {
+	for p.Next() {
+		if err := fn(p.Value()); err != nil {
+			return err
+		}
+	}
+	return p.Err()
+}
+
+// load fetches the next page, or waits for it if prefetched
+func (p *Pager) load() {
+	var result pageResult
+	if p.pending != nil {
+		result = <-p.pending
+		p.pending = nil
+	} else {
+		result = p.get(p.page + 1)
+	}
+	p.page++
+
+	p.items, p.index = result.items, 0
+	p.err = result.err
+	// An empty page ends the list, even if the API does not tell it is the last
+	p.last = result.last || len(result.items) == 0
+	if p.Prefetch && !p.last && p.err == nil {
+		p.pending = make(chan pageResult, 1)
+		go func(page int, pending chan<- pageResult) {
+			pending <- p.get(page)
+		}(p.page+1, p.pending)
+	}
+}
+
+func (p *Pager) get(page int) pageResult {
+	items, last, err := p.fetch(page)
+	if err != nil {
+		return pageResult{err: err}
+	}
+	return pageResult{items: items, last: last}
+}
+
+// LastPage tells whether page is the last page of a list of total items
+// returned pageSize at a time
+func LastPage(page, pageSize, total int) bool {
+	return pageSize <= 0 || page*pageSize >= total
+}
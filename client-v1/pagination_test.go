@@ -0,0 +1,97 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedList returns a PageFunc serving items pageSize at a time, recording the
+// pages fetched
+func pagedList(items []int, pageSize int, fetched *[]int, mu *sync.Mutex) PageFunc {
+	return func(page int) ([]interface{}, bool, error) {
+		mu.Lock()
+		*fetched = append(*fetched, page)
+		mu.Unlock()
+
+		values := []interface{}{}
+		for i := (page - 1) * pageSize; i < page*pageSize && i < len(items); i++ {
+			values = append(values, items[i])
+		}
+		return values, LastPage(page, pageSize, len(items)), nil
+	}
+}
+
+func TestPager(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		var mu sync.Mutex
+		var fetched []int
+		pager := NewPager(pagedList([]int{1, 2, 3, 4, 5}, 2, &fetched, &mu))
+		pager.Prefetch = prefetch
+
+		assert.True(t, pager.Next())
+		assert.Equal(t, 1, pager.Value())
+		assert.Equal(t, 1, pager.Page())
+
+		values := []interface{}{pager.Value()}
+		for pager.Next() {
+			values = append(values, pager.Value())
+		}
+		assert.NoError(t, pager.Err())
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, values)
+		assert.Equal(t, []int{1, 2, 3}, fetched)
+		assert.False(t, pager.Next())
+	}
+}
+
+func TestPager_Lazy(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []int
+	pager := NewPager(pagedList([]int{1, 2, 3, 4, 5}, 2, &fetched, &mu))
+
+	assert.Equal(t, 0, pager.Page())
+	assert.True(t, pager.Next())
+	assert.True(t, pager.Next())
+	assert.Equal(t, []int{1}, fetched)
+}
+
+func TestPager_EmptyPage(t *testing.T) {
+	calls := 0
+	pager := NewPager(func(page int) ([]interface{}, bool, error) {
+		calls++
+		return nil, false, nil
+	})
+
+	assert.False(t, pager.Next())
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, 1, calls)
+}
+
+func TestPager_ForEach(t *testing.T) {
+	boom := errors.New("boom")
+	pager := NewPager(func(page int) ([]interface{}, bool, error) {
+		if page == 2 {
+			return nil, false, boom
+		}
+		return []interface{}{"a", "b"}, false, nil
+	})
+	pager.Prefetch = true
+
+	var values []interface{}
+	err := pager.ForEach(func(item interface{}) error {
+		values = append(values, item)
+		return nil
+	})
+	assert.Equal(t, boom, err)
+	assert.Equal(t, []interface{}{"a", "b"}, values)
+
+	stop := errors.New("stop")
+	pager = NewPager(func(page int) ([]interface{}, bool, error) {
+		return []interface{}{"a", "b"}, true, nil
+	})
+	assert.Equal(t, stop, pager.ForEach(func(item interface{}) error {
+		return stop
+	}))
+}
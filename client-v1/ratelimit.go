@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+)
+
+// RateLimiter paces the requests made by Do and Session.Do, so that bulk jobs
+// wait for their budget instead of being throttled by the API.
+//
+// Budgets are token buckets kept per API host and path prefix, e.g.
+// "akab-xxx.luna.akamaiapis.net/papi/v1". They are learned from the
+// Akamai-RateLimit-Limit and Akamai-RateLimit-Remaining response headers, or
+// X-RateLimit-Limit and X-RateLimit-Remaining, and refilled at Limit requests
+// per Period. When the budget is exhausted, or after a 429 Too Many Requests
+// response, callers are blocked until a request is allowed again, as told by
+// the Akamai-RateLimit-Next or Retry-After headers if present.
+type RateLimiter struct {
+	// Period is the window the limits apply to, one minute if 0
+	Period time.Duration
+
+	// DefaultLimit is the number of requests per Period allowed for a prefix
+	// until its limit is learned from a response. 0 means no limit.
+	DefaultLimit int
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// Budget is the current rate limit budget of an API host and path prefix
+type Budget struct {
+	// Limit is the number of requests allowed per Period, 0 if unknown
+	Limit int
+
+	// Remaining is the number of requests which can be sent right now
+	Remaining int
+
+	// Next, if not zero, is the time before which no request is sent
+	Next time.Time
+}
+
+type bucket struct {
+	limit   int
+	tokens  float64
+	updated time.Time
+	next    time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter learning the limits from the responses
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{}
+}
+
+// Wait blocks until req may be sent according to the budget of its prefix,
+// and takes a request from the budget. It returns ctx.Err() if ctx is done first.
+func (l *RateLimiter) Wait(ctx context.Context, req *http.Request) error {
+	key := rateLimitKey(req.URL)
+	for {
+		l.mu.Lock()
+		delay := l.bucket(key).take(l.clock(), l.period())
+		l.mu.Unlock()
+		if delay <= 0 {
+			return nil
+		}
+
+		fields := edgegrid.RequestLogFields(req)
+		edgegrid.LoggerFromContext(ctx).Debug(fmt.Sprintf("Waiting %s for the rate limit", delay), fields)
+		if err := Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// Observe updates the budget of the prefix of the request of res from the
+// rate limit headers of res
+func (l *RateLimiter) Observe(res *http.Response) {
+	if res == nil || res.Request == nil {
+		return
+	}
+	limit, hasLimit := rateLimitHeader(res.Header, "Limit")
+	remaining, hasRemaining := rateLimitHeader(res.Header, "Remaining")
+	throttled := res.StatusCode == http.StatusTooManyRequests
+	if !hasLimit && !hasRemaining && !throttled {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock()
+	b := l.bucket(rateLimitKey(res.Request.URL))
+	b.refill(now, l.period())
+	if hasLimit {
+		b.limit = limit
+		b.tokens = math.Min(b.tokens, float64(limit))
+	}
+	if hasRemaining {
+		b.tokens = float64(remaining)
+	}
+	if throttled || (hasRemaining && remaining == 0) {
+		b.tokens = 0
+		if wait := RetryAfter(res); wait > 0 {
+			b.next = now.Add(wait)
+		} else if next, err := time.Parse(time.RFC3339Nano, res.Header.Get("Akamai-RateLimit-Next")); err == nil {
+			b.next = next
+		}
+	}
+}
+
+// Budgets returns the current budgets, by API host and path prefix
+func (l *RateLimiter) Budgets() map[string]Budget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock()
+	budgets := make(map[string]Budget, len(l.buckets))
+	for key, b := range l.buckets {
+		b.refill(now, l.period())
+		budget := Budget{Limit: b.limit, Remaining: int(b.tokens)}
+		if b.next.After(now) {
+			budget.Next = b.next
+			budget.Remaining = 0
+		}
+		budgets[key] = budget
+	}
+	return budgets
+}
+
+// limit returns next, paced by the limiter
+func (l *RateLimiter) limit(next Handler) Handler {
+	if l == nil {
+		return next
+	}
+	return func(req *http.Request) (*http.Response, error) {
+		if err := l.Wait(req.Context(), req); err != nil {
+			return nil, err
+		}
+		res, err := next(req)
+		if err == nil {
+			l.Observe(res)
+		}
+		return res, err
+	}
+}
+
+func (l *RateLimiter) bucket(key string) *bucket {
+	if l.buckets == nil {
+		l.buckets = make(map[string]*bucket)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: l.DefaultLimit, tokens: float64(l.DefaultLimit), updated: l.clock()}
+		l.buckets[key] = b
+	}
+	return b
+}
+
+func (l *RateLimiter) period() time.Duration {
+	if l.Period > 0 {
+		return l.Period
+	}
+	return time.Minute
+}
+
+func (l *RateLimiter) clock() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
+
+// refill adds the tokens earned since the last update
+func (b *bucket) refill(now time.Time, period time.Duration) {
+	if b.limit > 0 && now.After(b.updated) {
+		earned := float64(now.Sub(b.updated)) / float64(period) * float64(b.limit)
+		b.tokens = math.Min(float64(b.limit), b.tokens+earned)
+	}
+	b.updated = now
+}
+
+// take takes a token, returning how long to wait first if none is available
+func (b *bucket) take(now time.Time, period time.Duration) time.Duration {
+	b.refill(now, period)
+	if now.Before(b.next) {
+		return b.next.Sub(now)
+	}
+	if b.limit <= 0 {
+		return 0
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / float64(b.limit) * float64(period))
+}
+
+// rateLimitKey returns the host and the first two path segments of u
+func rateLimitKey(u *url.URL) string {
+	segments := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return u.Host + "/" + strings.Join(segments, "/")
+}
+
+// rateLimitHeader returns the value of the Akamai-RateLimit- or X-RateLimit- header named name
+func rateLimitHeader(header http.Header, name string) (int, bool) {
+	for _, prefix := range []string{"Akamai-RateLimit-", "X-RateLimit-"} {
+		if value, err := strconv.Atoi(header.Get(prefix + name)); err == nil && value >= 0 {
+			return value, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rateLimitedURL = "https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/papi/v1/groups"
+const rateLimitedKey = "akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/papi/v1"
+
+func rateLimitedResponse(status int, header http.Header) *http.Response {
+	req, _ := http.NewRequest("GET", rateLimitedURL, nil)
+	return &http.Response{StatusCode: status, Header: header, Request: req}
+}
+
+func TestRateLimiter_ObserveAndBudgets(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := &RateLimiter{now: func() time.Time { return now }}
+
+	limiter.Observe(rateLimitedResponse(200, http.Header{
+		"Akamai-Ratelimit-Limit":     []string{"60"},
+		"Akamai-Ratelimit-Remaining": []string{"10"},
+	}))
+	assert.Equal(t, map[string]Budget{rateLimitedKey: {Limit: 60, Remaining: 10}}, limiter.Budgets())
+
+	// One request per second is earned back
+	now = now.Add(5 * time.Second)
+	assert.Equal(t, 15, limiter.Budgets()[rateLimitedKey].Remaining)
+
+	next := now.Add(30 * time.Second)
+	limiter.Observe(rateLimitedResponse(429, http.Header{
+		"Akamai-Ratelimit-Next": []string{next.Format(time.RFC3339Nano)},
+	}))
+	assert.Equal(t, Budget{Limit: 60, Remaining: 0, Next: next}, limiter.Budgets()[rateLimitedKey])
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := &RateLimiter{Period: 100 * time.Millisecond}
+	limiter.Observe(rateLimitedResponse(200, http.Header{
+		"X-Ratelimit-Limit":     []string{"2"},
+		"X-Ratelimit-Remaining": []string{"1"},
+	}))
+	req, _ := http.NewRequest("GET", rateLimitedURL, nil)
+
+	start := time.Now()
+	assert.NoError(t, limiter.Wait(context.Background(), req))
+	assert.True(t, time.Since(start) < 40*time.Millisecond)
+
+	// The budget is exhausted, the next request is allowed in Period/Limit
+	assert.NoError(t, limiter.Wait(context.Background(), req))
+	assert.True(t, time.Since(start) >= 40*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, limiter.Wait(ctx, req))
+}
+
+func TestRateLimiter_Unknown(t *testing.T) {
+	limiter := NewRateLimiter()
+	req, _ := http.NewRequest("GET", rateLimitedURL, nil)
+
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, limiter.Wait(context.Background(), req))
+	}
+	assert.Equal(t, Budget{}, limiter.Budgets()[rateLimitedKey])
+}
+
+func TestRateLimitKey(t *testing.T) {
+	u, _ := url.Parse("https://host.luna.akamaiapis.net/config-dns/v2/zones/example.com")
+	assert.Equal(t, "host.luna.akamaiapis.net/config-dns/v2", rateLimitKey(u))
+
+	u, _ = url.Parse("https://host.luna.akamaiapis.net/")
+	assert.Equal(t, "host.luna.akamaiapis.net/", rateLimitKey(u))
+}
+
+func TestSession_RateLimiter(t *testing.T) {
+	session, requests, closeServer := retryServer(http.Header{"Retry-After": []string{"3600"}}, 429)
+	defer closeServer()
+	session.Retry = &RetryPolicy{}
+	session.RateLimiter = NewRateLimiter()
+
+	req, _ := session.NewRequest("GET", "/papi/v1/groups", nil)
+	res, err := session.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 429, res.StatusCode)
+
+	// The next request is blocked by the limiter until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ = session.WithContext(ctx).NewRequest("GET", "/papi/v1/groups", nil)
+	_, err = session.Do(req)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Len(t, *requests, 1)
+}
+
+func TestSession_RateLimiterOptIn(t *testing.T) {
+	session, requests, closeServer := retryServer(http.Header{"Retry-After": []string{"3600"}}, 429)
+	defer closeServer()
+	session.Retry = nil
+
+	for i := 0; i < 2; i++ {
+		req, _ := session.NewRequest("GET", "/papi/v1/groups", nil)
+		_, err := session.Do(req)
+		assert.NoError(t, err)
+	}
+	assert.Len(t, *requests, 2)
+}
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+)
+
+// RetryPolicy configures how Do and Session.Do retry failed requests.
+//
+// A request is retried when it fails with a network error such as a connection
+// reset, or gets a 429 Too Many Requests, 500, 502, 503 or 504 response.
+// Requests with a method that is not idempotent, POST or PATCH, are only
+// retried on 429 responses, which are sent before processing the request,
+// unless RetryNonIdempotent is set. Requests with a body that cannot be
+// replayed, i.e. without GetBody, are never retried.
+//
+// The delay before each retry grows from InitialInterval by Multiplier, up to
+// MaxInterval, and is randomized by +/- Jitter. It is at least the delay asked
+// for by the Retry-After or Akamai-RateLimit-Next headers of the response.
+// Each retry is signed again, with a fresh timestamp and nonce.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries of a request, 0 disables retries
+	MaxRetries int
+
+	// InitialInterval is the delay before the first retry
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay computed from InitialInterval and Multiplier
+	MaxInterval time.Duration
+
+	// Multiplier is the factor applied to the delay after each retry
+	Multiplier float64
+
+	// Jitter randomizes the delays by up to this fraction, e.g. 0.2 for +/- 20%
+	Jitter float64
+
+	// MaxElapsedTime, if not 0, is the time after the first attempt past which
+	// no retry is made. A response asking to wait past it is returned as is.
+	MaxElapsedTime time.Duration
+
+	// RetryNonIdempotent allows retrying POST and PATCH requests on any
+	// retryable failure, e.g. when the API deduplicates them
+	RetryNonIdempotent bool
+}
+
+// NewRetryPolicy returns a RetryPolicy making up to 3 retries, waiting from
+// 500ms to 30s, for at most 2 minutes
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:      3,
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxElapsedTime:  2 * time.Minute,
+	}
+}
+
+// retry returns next, retrying as allowed by the policy. The requests are
+// signed by next.
+func (p *RetryPolicy) retry(next Handler) Handler {
+	if p == nil || p.MaxRetries <= 0 {
+		return next
+	}
+	return func(req *http.Request) (*http.Response, error) {
+		return p.do(req, next)
+	}
+}
+
+// do sends req with send until it succeeds or may not be retried
+func (p *RetryPolicy) do(req *http.Request, send Handler) (*http.Response, error) {
+	start := time.Now()
+	interval := p.InitialInterval
+	attempt := req
+	for retries := 0; ; retries++ {
+		res, err := send(attempt)
+		if retries >= p.MaxRetries || !p.retryable(req, res, err) {
+			return res, err
+		}
+
+		delay := p.jitter(interval)
+		interval = time.Duration(float64(interval) * p.Multiplier)
+		if p.MaxInterval > 0 && interval > p.MaxInterval {
+			interval = p.MaxInterval
+		}
+		if wait := RetryAfter(res); wait > delay {
+			delay = wait
+		}
+		if p.MaxElapsedTime > 0 && time.Since(start)+delay > p.MaxElapsedTime {
+			return res, err
+		}
+
+		retry, ok := rewind(req)
+		if !ok {
+			return res, err
+		}
+
+		fields := edgegrid.RequestLogFields(req)
+		fields[edgegrid.LogFieldAttempt] = retries + 1
+		if err != nil {
+			fields[edgegrid.LogFieldError] = err
+		} else {
+			fields[edgegrid.LogFieldStatus] = res.StatusCode
+			io.Copy(ioutil.Discard, res.Body)
+			res.Body.Close()
+		}
+		edgegrid.LoggerFromContext(req.Context()).Warn(fmt.Sprintf("Retrying API request in %s", delay), fields)
+
+		if err := Sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+		attempt = retry
+	}
+}
+
+// retryable tells whether the outcome of req is worth retrying
+func (p *RetryPolicy) retryable(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		return p.idempotent(req) && isTransientError(err)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return p.idempotent(req)
+	}
+	return false
+}
+
+func (p *RetryPolicy) idempotent(req *http.Request) bool {
+	if p.RetryNonIdempotent {
+		return true
+	}
+	return req.Method != http.MethodPost && req.Method != http.MethodPatch
+}
+
+// jitter randomizes d by +/- Jitter
+func (p *RetryPolicy) jitter(d time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return d
+	}
+	return time.Duration(float64(d) * (1 + p.Jitter*(2*rand.Float64()-1)))
+}
+
+// isTransientError tells whether err is a network error that may not happen again
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// rewind returns a copy of req with a fresh body, if the body can be replayed
+func rewind(req *http.Request) (*http.Request, bool) {
+	next := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return next, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	next.Body = body
+	return next, true
+}
+
+// RetryAfter returns the delay asked for by res before sending another
+// request, from its Retry-After header or, for 429 responses, its
+// Akamai-RateLimit-Next header. It returns 0 if there is none.
+func RetryAfter(res *http.Response) time.Duration {
+	if res == nil {
+		return 0
+	}
+
+	if value := res.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return time.Until(date)
+		}
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		if next, err := time.Parse(time.RFC3339Nano, res.Header.Get("Akamai-RateLimit-Next")); err == nil {
+			return time.Until(next)
+		}
+	}
+	return 0
+}
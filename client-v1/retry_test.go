@@ -0,0 +1,147 @@
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedRequest struct {
+	authorization string
+	body          string
+}
+
+// retryServer replies to each request with the next of statuses, and 200 once
+// they are exhausted
+func retryServer(header http.Header, statuses ...int) (*Session, *[]recordedRequest, func()) {
+	var requests []recordedRequest
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, recordedRequest{authorization: r.Header.Get("Authorization"), body: string(body)})
+
+		status := http.StatusOK
+		if len(statuses) > 0 {
+			status, statuses = statuses[0], statuses[1:]
+			for key := range header {
+				w.Header().Set(key, header.Get(key))
+			}
+		}
+		w.WriteHeader(status)
+	}))
+
+	session := NewSession(edgegrid.Config{
+		Host:         server.URL,
+		ClientToken:  "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		MaxBody:      2048,
+	})
+	session.HTTPClient = server.Client()
+	session.Retry = &RetryPolicy{
+		MaxRetries:      3,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     10 * time.Millisecond,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxElapsedTime:  time.Minute,
+	}
+	return session, &requests, server.Close
+}
+
+func TestRetry_ResignsAndReplaysBody(t *testing.T) {
+	session, requests, closeServer := retryServer(nil, 503, 502)
+	defer closeServer()
+
+	req, _ := session.NewJSONRequest("PUT", "/papi/v1/properties/prp_1", map[string]string{"name": "example"})
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	if assert.Len(t, *requests, 3) {
+		for _, recorded := range *requests {
+			assert.Equal(t, `{"name":"example"}`, recorded.body)
+		}
+		assert.NotEqual(t, (*requests)[0].authorization, (*requests)[1].authorization)
+		assert.NotEqual(t, (*requests)[1].authorization, (*requests)[2].authorization)
+	}
+}
+
+func TestRetry_OptIn(t *testing.T) {
+	session, requests, closeServer := retryServer(nil, 503)
+	defer closeServer()
+	session.Retry = nil
+
+	req, _ := session.NewRequest("GET", "/papi/v1/groups", nil)
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 503, res.StatusCode)
+	assert.Len(t, *requests, 1)
+}
+
+func TestRetry_NonIdempotent(t *testing.T) {
+	session, requests, closeServer := retryServer(nil, 500)
+	defer closeServer()
+
+	req, _ := session.NewJSONRequest("POST", "/papi/v1/properties", nil)
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 500, res.StatusCode)
+	assert.Len(t, *requests, 1)
+}
+
+func TestRetry_TooManyRequests(t *testing.T) {
+	session, requests, closeServer := retryServer(http.Header{"Retry-After": []string{"1"}}, 429)
+	defer closeServer()
+
+	req, _ := session.NewJSONRequest("POST", "/papi/v1/properties", map[string]string{"name": "example"})
+	start := time.Now()
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Len(t, *requests, 2)
+	assert.True(t, time.Since(start) >= time.Second)
+}
+
+func TestRetry_MaxElapsedTime(t *testing.T) {
+	session, requests, closeServer := retryServer(http.Header{"Retry-After": []string{"3600"}}, 503)
+	defer closeServer()
+
+	req, _ := session.NewRequest("GET", "/papi/v1/groups", nil)
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 503, res.StatusCode)
+	assert.Len(t, *requests, 1)
+}
+
+func TestRetry_MaxRetries(t *testing.T) {
+	session, requests, closeServer := retryServer(nil, 504, 504, 504, 504, 504)
+	defer closeServer()
+
+	req, _ := session.NewRequest("GET", "/papi/v1/groups", nil)
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 504, res.StatusCode)
+	assert.Len(t, *requests, 4)
+}
+
+func TestRetryAfter(t *testing.T) {
+	res := &http.Response{StatusCode: 429, Header: http.Header{}}
+	assert.Equal(t, time.Duration(0), RetryAfter(res))
+
+	res.Header.Set("Akamai-RateLimit-Next", time.Now().Add(time.Minute).UTC().Format(time.RFC3339Nano))
+	wait := RetryAfter(res)
+	assert.True(t, wait > 50*time.Second && wait <= time.Minute, wait)
+
+	res.Header.Set("Retry-After", "120")
+	assert.Equal(t, 2*time.Minute, RetryAfter(res))
+}
@@ -0,0 +1,319 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+)
+
+// Hooks are optional callbacks run by a Session around every request, before
+// it is signed and once its outcome is known. They are a shorthand for the
+// RequestHook and ResponseHook middleware.
+type Hooks struct {
+	// BeforeRequest is called with each request before it is signed and sent
+	BeforeRequest func(req *http.Request)
+
+	// AfterResponse is called with the outcome of each request
+	AfterResponse func(res *http.Response, err error)
+}
+
+// Session holds everything needed to talk to the Akamai APIs on behalf of
+// one account: credentials, HTTP client, logger and hooks. Service clients
+// are created from a Session, e.g. papi.New(session), so a process can use
+// several accounts at the same time.
+//
+//	config, _ := edgegrid.Init("~/.edgerc", "default")
+//	session := client.NewSession(config)
+//	zones, err := dnsv2.New(session).ListZones()
+//
+// The fields must not be modified once the Session is in use.
+type Session struct {
+	// Config holds the credentials used to sign requests
+	Config edgegrid.Config
+
+	// Credentials, if set, is asked for the credentials of each request and
+	// takes precedence over Config, e.g. an *edgegrid.Watcher
+	Credentials edgegrid.CredentialProvider
+
+	// HTTPClient sends the requests. If nil, the package-level Client is used.
+	// Requests are signed by an edgegrid.Transport wrapping its Transport.
+	HTTPClient *http.Client
+
+	// Logger, if set, receives the logs of the requests made with the
+	// Session instead of edgegrid.GetLogger()
+	Logger edgegrid.Logger
+
+	// Hooks are run around every request
+	Hooks Hooks
+
+	// Retry, if set, retries failed requests, e.g. NewRetryPolicy(). If nil,
+	// the package-level Retry policy is used, none by default.
+	Retry *RetryPolicy
+
+	// RateLimiter, if set, paces the requests, e.g. NewRateLimiter(). If nil,
+	// the package-level RateLimit is used, none by default.
+	RateLimiter *RateLimiter
+
+	// Cache, if set, serves the GET requests, e.g. NewResponseCache(). If nil,
+	// the package-level Cache is used, none by default.
+	Cache *ResponseCache
+
+	middleware       []Middleware
+	signedMiddleware []Middleware
+
+	ctx    context.Context
+	once   sync.Once
+	signer *edgegrid.Signer
+}
+
+// middleware returns the Hooks as middleware
+func (h Hooks) middleware() []Middleware {
+	var middleware []Middleware
+	if h.BeforeRequest != nil {
+		middleware = append(middleware, RequestHook(h.BeforeRequest))
+	}
+	if h.AfterResponse != nil {
+		middleware = append(middleware, ResponseHook(h.AfterResponse))
+	}
+	return middleware
+}
+
+// NewSession creates a new Session using config and the package-level Client
+func NewSession(config edgegrid.Config) *Session {
+	return &Session{Config: config}
+}
+
+// NewLiveSession creates a new Session reading *config each time credentials
+// are needed, so that later changes to *config are picked up. The service
+// packages use it for their package-level functions, with their Config variable.
+func NewLiveSession(config *edgegrid.Config) *Session {
+	return &Session{Credentials: liveConfig{config: config}}
+}
+
+type liveConfig struct {
+	config *edgegrid.Config
+}
+
+func (c liveConfig) Retrieve() (edgegrid.Config, error) {
+	return *c.config, nil
+}
+
+// NewProviderSession creates a new Session retrieving its credentials from provider
+func NewProviderSession(provider edgegrid.CredentialProvider) *Session {
+	return &Session{Credentials: provider}
+}
+
+// GetConfig returns the credentials of the Session
+func (s *Session) GetConfig() (edgegrid.Config, error) {
+	if s.Credentials != nil {
+		return s.Credentials.Retrieve()
+	}
+	return s.Config, nil
+}
+
+// Use adds middleware to the calls made with the Session, before signing. It
+// is run after the middleware added with the package-level Use.
+func (s *Session) Use(mw ...Middleware) {
+	s.middleware = append(s.middleware[:len(s.middleware):len(s.middleware)], mw...)
+}
+
+// UseSigned adds middleware to the attempts made with the Session, after signing
+func (s *Session) UseSigned(mw ...Middleware) {
+	s.signedMiddleware = append(s.signedMiddleware[:len(s.signedMiddleware):len(s.signedMiddleware)], mw...)
+}
+
+// WithContext returns a copy of the Session making its requests with ctx,
+// which must be non-nil. The copy shares the credentials, HTTP client, logger
+// and hooks of the Session. Service clients have a WithContext method too:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//	zones, err := dnsv2.New(session).WithContext(ctx).ListZones()
+func (s *Session) WithContext(ctx context.Context) *Session {
+	if ctx == nil {
+		panic("nil context")
+	}
+
+	session := &Session{
+		Config:      s.Config,
+		Credentials: s.Credentials,
+		HTTPClient:  s.HTTPClient,
+		Logger:      s.Logger,
+		Hooks:       s.Hooks,
+		Retry:       s.Retry,
+		RateLimiter: s.RateLimiter,
+		Cache:       s.Cache,
+
+		middleware:       s.middleware,
+		signedMiddleware: s.signedMiddleware,
+
+		ctx:    ctx,
+		signer: s.getSigner(),
+	}
+	session.once.Do(func() {})
+	return session
+}
+
+// Context returns the context of the Session, context.Background() unless
+// set with WithContext
+func (s *Session) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+// NewRequest creates an HTTP request for the Session
+//
+// See: NewRequest()
+func (s *Session) NewRequest(method, path string, body io.Reader) (*http.Request, error) {
+	config, err := s.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	req, err := NewRequestWithContext(s.Context(), config, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.bind(req), nil
+}
+
+// NewJSONRequest creates an HTTP request with a JSON body for the Session
+//
+// See: NewJSONRequest()
+func (s *Session) NewJSONRequest(method, path string, body interface{}) (*http.Request, error) {
+	config, err := s.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	req, err := NewJSONRequestWithContext(s.Context(), config, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.bind(req), nil
+}
+
+// NewMultiPartFormDataRequest creates an HTTP request uploading a file for the Session
+//
+// See: NewMultiPartFormDataRequest()
+func (s *Session) NewMultiPartFormDataRequest(uriPath, filePath string, otherFormParams map[string]string) (*http.Request, error) {
+	config, err := s.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	req, err := NewMultiPartFormDataRequestWithContext(s.Context(), config, uriPath, filePath, otherFormParams)
+	if err != nil {
+		return nil, err
+	}
+	return s.bind(req), nil
+}
+
+// NewMultiPartFormDataStreamRequest creates an HTTP request streaming an upload for the Session
+//
+// See: NewMultiPartFormDataStreamRequest()
+func (s *Session) NewMultiPartFormDataStreamRequest(uriPath, fieldName, fileName string, file io.Reader, otherFormParams map[string]string) (*http.Request, error) {
+	config, err := s.GetConfig()
+	if err != nil {
+		if closer, ok := file.(io.Closer); ok {
+			closer.Close()
+		}
+		return nil, err
+	}
+	req, err := NewMultiPartFormDataStreamRequestWithContext(s.Context(), config, uriPath, fieldName, fileName, file, otherFormParams)
+	if err != nil {
+		return nil, err
+	}
+	return s.bind(req), nil
+}
+
+// Do signs and sends req. Redirects are signed as well, and a request rejected
+// because of a skewed local clock is retried once, see edgegrid.Transport.
+// GET requests are served from the Cache when possible. Requests are paced
+// by the RateLimiter, and failed requests are retried according to the Retry
+// policy.
+//
+// Every call goes through the middleware added with Use, and is logged with
+// the service, operation and status fields.
+func (s *Session) Do(req *http.Request) (*http.Response, error) {
+	global, _ := globalMiddleware()
+	middleware := append([]Middleware{logCalls}, global...)
+	middleware = append(append(middleware, s.middleware...), s.Hooks.middleware()...)
+	send := s.responseCache().cache(s.retryPolicy().retry(s.rateLimiter().limit(s.client().Do)))
+
+	return Chain(send, middleware...)(s.bind(req))
+}
+
+// DoWithContext signs and sends req like Do, with ctx
+func (s *Session) DoWithContext(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return s.Do(req.WithContext(ctx))
+}
+
+// CloseIdleConnections closes the idle connections of the HTTP client of the Session
+func (s *Session) CloseIdleConnections() {
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = Client
+	}
+	httpClient.CloseIdleConnections()
+}
+
+func (s *Session) retryPolicy() *RetryPolicy {
+	if s.Retry != nil {
+		return s.Retry
+	}
+	return Retry
+}
+
+func (s *Session) responseCache() *ResponseCache {
+	if s.Cache != nil {
+		return s.Cache
+	}
+	return Cache
+}
+
+func (s *Session) rateLimiter() *RateLimiter {
+	if s.RateLimiter != nil {
+		return s.RateLimiter
+	}
+	return RateLimit
+}
+
+// bind attaches the Session logger to req
+func (s *Session) bind(req *http.Request) *http.Request {
+	if s.Logger == nil {
+		return req
+	}
+	return req.WithContext(edgegrid.WithLogger(req.Context(), s.Logger))
+}
+
+// client returns a copy of the HTTP client of the Session whose transport
+// signs the requests, then runs the signed middleware
+func (s *Session) client() *http.Client {
+	signer := s.getSigner()
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = Client
+	}
+
+	_, global := globalMiddleware()
+	middleware := append(append([]Middleware{}, global...), s.signedMiddleware...)
+
+	signed := *httpClient
+	signed.Transport = &edgegrid.Transport{Signer: signer, Base: roundTripper(httpClient.Transport, middleware...)}
+	return &signed
+}
+
+// getSigner returns the signer of the Session, created on first use
+func (s *Session) getSigner() *edgegrid.Signer {
+	s.once.Do(func() {
+		if s.Credentials != nil {
+			s.signer = edgegrid.NewProviderSigner(s.Credentials)
+		} else {
+			s.signer = edgegrid.NewSigner(s.Config)
+		}
+	})
+	return s.signer
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+type sessionLogger struct {
+	messages []string
+}
+
+func (l *sessionLogger) Trace(msg string, _ edgegrid.Fields) { l.record(msg) }
+func (l *sessionLogger) Debug(msg string, _ edgegrid.Fields) { l.record(msg) }
+func (l *sessionLogger) Info(msg string, _ edgegrid.Fields)  { l.record(msg) }
+func (l *sessionLogger) Warn(msg string, _ edgegrid.Fields)  { l.record(msg) }
+func (l *sessionLogger) Error(msg string, _ edgegrid.Fields) { l.record(msg) }
+
+func (l *sessionLogger) record(msg string) {
+	l.messages = append(l.messages, msg)
+}
+
+func TestSession_TwoAccounts(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://akaa-first-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/papi/v1/groups").
+		MatchHeader("Authorization", "client_token=first;").
+		Reply(200)
+	gock.New("https://akaa-second-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/papi/v1/groups").
+		MatchHeader("Authorization", "client_token=second;").
+		Reply(200)
+
+	for _, name := range []string{"first", "second"} {
+		session := NewSession(edgegrid.Config{
+			Host:         "akaa-" + name + "-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net",
+			ClientToken:  name,
+			AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+			ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+			MaxBody:      2048,
+		})
+
+		req, err := session.NewRequest("GET", "/papi/v1/groups", nil)
+		assert.NoError(t, err)
+		res, err := session.Do(req)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestSession_HooksAndLogger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/papi/v1/groups").
+		HeaderPresent("Authorization").
+		Reply(404)
+
+	logger := &sessionLogger{}
+	var before *http.Request
+	var after *http.Response
+	session := NewSession(edgegrid.Config{
+		Host:         "akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net",
+		ClientToken:  "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		MaxBody:      2048,
+	})
+	session.Logger = logger
+	session.Hooks = Hooks{
+		BeforeRequest: func(req *http.Request) { before = req },
+		AfterResponse: func(res *http.Response, err error) { after = res },
+	}
+
+	req, err := session.NewRequest("GET", "/papi/v1/groups", nil)
+	assert.NoError(t, err)
+	edgegrid.PrintHttpRequest(req, false)
+	res, err := session.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 404, res.StatusCode)
+	assert.NotNil(t, before)
+	assert.Equal(t, res, after)
+	assert.Contains(t, logger.messages, "API request completed")
+	assert.Contains(t, logger.messages, "GET /papi/v1/groups HTTP/1.1")
+}
+
+func TestSession_WithContext(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/papi/v1/groups").
+		Reply(200)
+
+	session := NewSession(edgegrid.Config{
+		Host:         "akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net",
+		ClientToken:  "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		MaxBody:      2048,
+	})
+	assert.Equal(t, context.Background(), session.Context())
+
+	// gock ignores the request context, so the canceled session uses a real transport
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := NewSession(session.Config)
+	canceled.HTTPClient = &http.Client{Transport: &http.Transport{}}
+	canceled = canceled.WithContext(ctx)
+	cancel()
+
+	req, err := canceled.NewRequest("GET", "/papi/v1/groups", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ctx, req.Context())
+	_, err = canceled.Do(req)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	req, err = session.NewRequest("GET", "/papi/v1/groups", nil)
+	assert.NoError(t, err)
+	res, err := session.Do(req)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 200, res.StatusCode)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	assert.NoError(t, Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, Sleep(ctx, time.Hour))
+}
@@ -2,6 +2,8 @@ package dns
 
 import (
 	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 )
 
 type ConfigDNSError interface {
@@ -73,6 +75,25 @@ func (e *ZoneError) Error() string {
 	return "<nil>"
 }
 
+// Is tells whether the error is of the category target, e.g. client.ErrNotFound:
+// that of the client.APIError or network error it wraps, if any.
+func (e *ZoneError) Is(target error) bool {
+	if e.err != nil {
+		return target == client.Category(e.err)
+	}
+	return target == client.ErrNotFound && e.NotFound()
+}
+
+// Unwrap returns the underlying error, e.g. a client.APIError
+func (e *ZoneError) Unwrap() error {
+	return e.err
+}
+
+// Retryable tells whether the failed call may succeed if made again, later
+func (e *ZoneError) Retryable() bool {
+	return client.IsRetryable(e.err)
+}
+
 type RecordError struct {
 	fieldName        string
 	httpErrorMessage string
@@ -123,3 +144,22 @@ func (e *RecordError) Error() string {
 
 	return "<nil>"
 }
+
+// Is tells whether the error is of the category target, e.g. client.ErrValidation:
+// that of the network error it wraps, if any.
+func (e *RecordError) Is(target error) bool {
+	if e.err != nil {
+		return target == client.Category(e.err)
+	}
+	return target == client.ErrValidation && e.ValidationFailed()
+}
+
+// Unwrap returns the underlying error
+func (e *RecordError) Unwrap() error {
+	return e.err
+}
+
+// Retryable tells whether the failed call may succeed if made again, later
+func (e *RecordError) Retryable() bool {
+	return client.IsRetryable(e.err)
+}
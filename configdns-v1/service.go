@@ -1,6 +1,8 @@
 package dns
 
 import (
+	"context"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 )
 
@@ -8,8 +10,26 @@ var (
 	// Config contains the Akamai OPEN Edgegrid API credentials
 	// for automatic signing of requests
 	Config edgegrid.Config
+
+	// defaultClient is used by the package-level functions and methods, with Config
+	defaultClient = New(client.NewLiveSession(&Config))
 )
 
+// Client is a FastDNS client bound to a client.Session
+type Client struct {
+	session *client.Session
+}
+
+// New creates a new FastDNS Client using session
+func New(session *client.Session) *Client {
+	return &Client{session: session}
+}
+
+// WithContext returns a copy of the Client making its requests with ctx
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return New(c.session.WithContext(ctx))
+}
+
 // Init sets the FastDNS edgegrid Config
 func Init(config edgegrid.Config) {
 	Config = config
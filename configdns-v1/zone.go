@@ -60,10 +60,9 @@ func NewZone(hostname string) *Zone {
 }
 
 // GetZone retrieves a DNS Zone for a given hostname
-func GetZone(hostname string) (*Zone, error) {
+func (c *Client) GetZone(hostname string) (*Zone, error) {
 	zone := NewZone(hostname)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		"/config-dns/v1/zones/"+hostname,
 		nil,
@@ -72,7 +71,7 @@ func GetZone(hostname string) (*Zone, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -91,8 +90,22 @@ func GetZone(hostname string) (*Zone, error) {
 	}
 }
 
+// GetZone retrieves a DNS Zone for a given hostname
+//
+// See: Client.GetZone()
+func GetZone(hostname string) (*Zone, error) {
+	return defaultClient.GetZone(hostname)
+}
+
 // Save updates the Zone
+//
+// See: Client.SaveZone()
 func (zone *Zone) Save() error {
+	return defaultClient.SaveZone(zone)
+}
+
+// Save updates the Zone
+func (c *Client) SaveZone(zone *Zone) error {
 	// This lock will restrict the concurrency of API calls
 	// to 1 save request at a time. This is needed for the Soa.Serial value which
 	// is required to be incremented for every subsequent update to a zone
@@ -113,8 +126,7 @@ func (zone *Zone) Save() error {
 		}
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/config-dns/v1/zones/"+zone.Zone.Name,
 		zone,
@@ -123,7 +135,7 @@ func (zone *Zone) Save() error {
 		return err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -141,7 +153,7 @@ func (zone *Zone) Save() error {
 	}
 
 	for {
-		updatedZone, err := GetZone(zone.Zone.Name)
+		updatedZone, err := c.GetZone(zone.Zone.Name)
 		if err != nil {
 			return err
 		}
@@ -150,13 +162,19 @@ func (zone *Zone) Save() error {
 			*zone = *updatedZone
 			break
 		}
-		time.Sleep(time.Second)
+		if err := client.Sleep(c.session.Context(), time.Second); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (zone *Zone) Delete() error {
+	return defaultClient.DeleteZone(zone)
+}
+
+func (c *Client) DeleteZone(zone *Zone) error {
 	// remove all the records except for SOA
 	// which is required and save the zone
 	zone.Zone.A = nil
@@ -180,7 +198,7 @@ func (zone *Zone) Delete() error {
 	zone.Zone.Sshfp = nil
 	zone.Zone.Txt = nil
 
-	return zone.Save()
+	return c.SaveZone(zone)
 }
 
 func (zone *Zone) AddRecord(recordPtr interface{}) error {
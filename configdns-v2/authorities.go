@@ -17,11 +17,10 @@ func NewAuthorityResponse(contract string) *AuthorityResponse {
 	return authorities
 }
 
-func GetAuthorities(contractId string) (*AuthorityResponse, error) {
+func (c *Client) GetAuthorities(contractId string) (*AuthorityResponse, error) {
 	authorities := NewAuthorityResponse(contractId)
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		"/config-dns/v2/data/authorities?contractIds="+contractId,
 		nil,
@@ -32,7 +31,7 @@ func GetAuthorities(contractId string) (*AuthorityResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -53,9 +52,13 @@ func GetAuthorities(contractId string) (*AuthorityResponse, error) {
 	}
 }
 
-func GetNameServerRecordList(contractId string) ([]string, error) {
+func GetAuthorities(contractId string) (*AuthorityResponse, error) {
+	return defaultClient.GetAuthorities(contractId)
+}
 
-	NSrecords, err := GetAuthorities(contractId)
+func (c *Client) GetNameServerRecordList(contractId string) ([]string, error) {
+
+	NSrecords, err := c.GetAuthorities(contractId)
 
 	if err != nil {
 		return nil, err
@@ -75,3 +78,7 @@ func GetNameServerRecordList(contractId string) ([]string, error) {
 	}
 	return ns, nil
 }
+
+func GetNameServerRecordList(contractId string) ([]string, error) {
+	return defaultClient.GetNameServerRecordList(contractId)
+}
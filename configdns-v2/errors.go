@@ -92,6 +92,25 @@ func (e *ZoneError) Error() string {
 	return "<nil>"
 }
 
+// Is tells whether the error is of the category target, e.g. client.ErrNotFound:
+// that of the client.APIError or network error it wraps, if any.
+func (e *ZoneError) Is(target error) bool {
+	if e.err != nil {
+		return target == client.Category(e.err)
+	}
+	return target == client.ErrNotFound && e.NotFound()
+}
+
+// Unwrap returns the underlying error, e.g. a client.APIError
+func (e *ZoneError) Unwrap() error {
+	return e.err
+}
+
+// Retryable tells whether the failed call may succeed if made again, later
+func (e *ZoneError) Retryable() bool {
+	return client.IsRetryable(e.err)
+}
+
 type RecordError struct {
 	fieldName        string
 	httpErrorMessage string
@@ -180,6 +199,25 @@ func (e *RecordError) Error() string {
 	return "<nil>"
 }
 
+// Is tells whether the error is of the category target, e.g. client.ErrNotFound:
+// that of the client.APIError or network error it wraps, if any.
+func (e *RecordError) Is(target error) bool {
+	if e.err != nil {
+		return target == client.Category(e.err)
+	}
+	return target == client.ErrNotFound && e.NotFound()
+}
+
+// Unwrap returns the underlying error, e.g. a client.APIError
+func (e *RecordError) Unwrap() error {
+	return e.err
+}
+
+// Retryable tells whether the failed call may succeed if made again, later
+func (e *RecordError) Retryable() bool {
+	return client.IsRetryable(e.err)
+}
+
 type TsigError struct {
 	keyName          string
 	httpErrorMessage string
@@ -231,3 +269,22 @@ func (e *TsigError) Error() string {
 
 	return "<nil>"
 }
+
+// Is tells whether the error is of the category target, e.g. client.ErrNotFound:
+// that of the client.APIError or network error it wraps, if any.
+func (e *TsigError) Is(target error) bool {
+	if e.err != nil {
+		return target == client.Category(e.err)
+	}
+	return target == client.ErrNotFound && e.NotFound()
+}
+
+// Unwrap returns the underlying error, e.g. a client.APIError
+func (e *TsigError) Unwrap() error {
+	return e.err
+}
+
+// Retryable tells whether the failed call may succeed if made again, later
+func (e *TsigError) Retryable() bool {
+	return client.IsRetryable(e.err)
+}
@@ -0,0 +1,225 @@
+package dnsv2
+
+import "sync"
+
+// Fake is a configurable implementation of ZoneService and RecordsetService,
+// for unit tests of code using them without any HTTP. Each method records
+// its call, then calls the function of its name with a Func suffix, e.g.
+// SubmitChangelistFunc, if set, or else returns zero values. IterZones and
+// IterRecordsets page through ListZones and GetRecordsets by default.
+//
+//	fake := &dnsv2.Fake{
+//		SubmitChangelistFunc: func(zone *dnsv2.ZoneCreate) error {
+//			return errors.New("boom")
+//		},
+//	}
+type Fake struct {
+	ListZonesFunc        func(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error)
+	IterZonesFunc        func(queryArgs ...ZoneListQueryArgs) *ZoneIterator
+	GetZoneFunc          func(zonename string) (*ZoneResponse, error)
+	SaveZoneFunc         func(zone *ZoneCreate, zonequerystring ZoneQueryString, clearConn ...bool) error
+	UpdateZoneFunc       func(zone *ZoneCreate, zonequerystring ZoneQueryString) error
+	DeleteZoneFunc       func(zone *ZoneCreate, zonequerystring ZoneQueryString) error
+	SaveChangelistFunc   func(zone *ZoneCreate) error
+	SubmitChangelistFunc func(zone *ZoneCreate) error
+	GetZoneNamesFunc     func(zone string) (*ZoneNamesResponse, error)
+	GetZoneNameTypesFunc func(zname string, zone string) (*ZoneNameTypesResponse, error)
+	GetRecordsetsFunc    func(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResponse, error)
+	IterRecordsetsFunc   func(zone string, queryArgs ...RecordsetQueryArgs) *RecordsetIterator
+	SaveRecordsetsFunc   func(recordsets *Recordsets, zone string, recLock ...bool) error
+	UpdateRecordsetsFunc func(recordsets *Recordsets, zone string, recLock ...bool) error
+	GetRecordFunc        func(zone string, name string, record_type string) (*RecordBody, error)
+	SaveRecordFunc       func(record *RecordBody, zone string, recLock ...bool) error
+	UpdateRecordFunc     func(record *RecordBody, zone string, recLock ...bool) error
+	DeleteRecordFunc     func(record *RecordBody, zone string, recLock ...bool) error
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+// FakeCall is a call of a method of a Fake, with its arguments
+type FakeCall struct {
+	Method string
+	Args   []interface{}
+}
+
+var (
+	_ ZoneService      = (*Fake)(nil)
+	_ RecordsetService = (*Fake)(nil)
+)
+
+// Calls returns the calls made so far, in order
+func (f *Fake) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// record records a call of method with args
+func (f *Fake) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args})
+}
+
+// ListZones calls ListZonesFunc
+func (f *Fake) ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
+	f.record("ListZones", queryArgs)
+	if f.ListZonesFunc == nil {
+		return nil, nil
+	}
+	return f.ListZonesFunc(queryArgs...)
+}
+
+// IterZones calls IterZonesFunc, or else pages through ListZones
+func (f *Fake) IterZones(queryArgs ...ZoneListQueryArgs) *ZoneIterator {
+	f.record("IterZones", queryArgs)
+	if f.IterZonesFunc == nil {
+		return iterZones(f, queryArgs...)
+	}
+	return f.IterZonesFunc(queryArgs...)
+}
+
+// GetZone calls GetZoneFunc
+func (f *Fake) GetZone(zonename string) (*ZoneResponse, error) {
+	f.record("GetZone", zonename)
+	if f.GetZoneFunc == nil {
+		return nil, nil
+	}
+	return f.GetZoneFunc(zonename)
+}
+
+// SaveZone calls SaveZoneFunc
+func (f *Fake) SaveZone(zone *ZoneCreate, zonequerystring ZoneQueryString, clearConn ...bool) error {
+	f.record("SaveZone", zone, zonequerystring, clearConn)
+	if f.SaveZoneFunc == nil {
+		return nil
+	}
+	return f.SaveZoneFunc(zone, zonequerystring, clearConn...)
+}
+
+// UpdateZone calls UpdateZoneFunc
+func (f *Fake) UpdateZone(zone *ZoneCreate, zonequerystring ZoneQueryString) error {
+	f.record("UpdateZone", zone, zonequerystring)
+	if f.UpdateZoneFunc == nil {
+		return nil
+	}
+	return f.UpdateZoneFunc(zone, zonequerystring)
+}
+
+// DeleteZone calls DeleteZoneFunc
+func (f *Fake) DeleteZone(zone *ZoneCreate, zonequerystring ZoneQueryString) error {
+	f.record("DeleteZone", zone, zonequerystring)
+	if f.DeleteZoneFunc == nil {
+		return nil
+	}
+	return f.DeleteZoneFunc(zone, zonequerystring)
+}
+
+// SaveChangelist calls SaveChangelistFunc
+func (f *Fake) SaveChangelist(zone *ZoneCreate) error {
+	f.record("SaveChangelist", zone)
+	if f.SaveChangelistFunc == nil {
+		return nil
+	}
+	return f.SaveChangelistFunc(zone)
+}
+
+// SubmitChangelist calls SubmitChangelistFunc
+func (f *Fake) SubmitChangelist(zone *ZoneCreate) error {
+	f.record("SubmitChangelist", zone)
+	if f.SubmitChangelistFunc == nil {
+		return nil
+	}
+	return f.SubmitChangelistFunc(zone)
+}
+
+// GetZoneNames calls GetZoneNamesFunc
+func (f *Fake) GetZoneNames(zone string) (*ZoneNamesResponse, error) {
+	f.record("GetZoneNames", zone)
+	if f.GetZoneNamesFunc == nil {
+		return nil, nil
+	}
+	return f.GetZoneNamesFunc(zone)
+}
+
+// GetZoneNameTypes calls GetZoneNameTypesFunc
+func (f *Fake) GetZoneNameTypes(zname string, zone string) (*ZoneNameTypesResponse, error) {
+	f.record("GetZoneNameTypes", zname, zone)
+	if f.GetZoneNameTypesFunc == nil {
+		return nil, nil
+	}
+	return f.GetZoneNameTypesFunc(zname, zone)
+}
+
+// GetRecordsets calls GetRecordsetsFunc
+func (f *Fake) GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResponse, error) {
+	f.record("GetRecordsets", zone, queryArgs)
+	if f.GetRecordsetsFunc == nil {
+		return nil, nil
+	}
+	return f.GetRecordsetsFunc(zone, queryArgs...)
+}
+
+// IterRecordsets calls IterRecordsetsFunc, or else pages through GetRecordsets
+func (f *Fake) IterRecordsets(zone string, queryArgs ...RecordsetQueryArgs) *RecordsetIterator {
+	f.record("IterRecordsets", zone, queryArgs)
+	if f.IterRecordsetsFunc == nil {
+		return iterRecordsets(f, zone, queryArgs...)
+	}
+	return f.IterRecordsetsFunc(zone, queryArgs...)
+}
+
+// SaveRecordsets calls SaveRecordsetsFunc
+func (f *Fake) SaveRecordsets(recordsets *Recordsets, zone string, recLock ...bool) error {
+	f.record("SaveRecordsets", recordsets, zone, recLock)
+	if f.SaveRecordsetsFunc == nil {
+		return nil
+	}
+	return f.SaveRecordsetsFunc(recordsets, zone, recLock...)
+}
+
+// UpdateRecordsets calls UpdateRecordsetsFunc
+func (f *Fake) UpdateRecordsets(recordsets *Recordsets, zone string, recLock ...bool) error {
+	f.record("UpdateRecordsets", recordsets, zone, recLock)
+	if f.UpdateRecordsetsFunc == nil {
+		return nil
+	}
+	return f.UpdateRecordsetsFunc(recordsets, zone, recLock...)
+}
+
+// GetRecord calls GetRecordFunc
+func (f *Fake) GetRecord(zone string, name string, record_type string) (*RecordBody, error) {
+	f.record("GetRecord", zone, name, record_type)
+	if f.GetRecordFunc == nil {
+		return nil, nil
+	}
+	return f.GetRecordFunc(zone, name, record_type)
+}
+
+// SaveRecord calls SaveRecordFunc
+func (f *Fake) SaveRecord(record *RecordBody, zone string, recLock ...bool) error {
+	f.record("SaveRecord", record, zone, recLock)
+	if f.SaveRecordFunc == nil {
+		return nil
+	}
+	return f.SaveRecordFunc(record, zone, recLock...)
+}
+
+// UpdateRecord calls UpdateRecordFunc
+func (f *Fake) UpdateRecord(record *RecordBody, zone string, recLock ...bool) error {
+	f.record("UpdateRecord", record, zone, recLock)
+	if f.UpdateRecordFunc == nil {
+		return nil
+	}
+	return f.UpdateRecordFunc(record, zone, recLock...)
+}
+
+// DeleteRecord calls DeleteRecordFunc
+func (f *Fake) DeleteRecord(record *RecordBody, zone string, recLock ...bool) error {
+	f.record("DeleteRecord", record, zone, recLock)
+	if f.DeleteRecordFunc == nil {
+		return nil
+	}
+	return f.DeleteRecordFunc(record, zone, recLock...)
+}
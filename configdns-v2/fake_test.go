@@ -0,0 +1,60 @@
+package dnsv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake_Funcs(t *testing.T) {
+	boom := errors.New("boom")
+	fake := &Fake{
+		GetZoneFunc: func(zonename string) (*ZoneResponse, error) {
+			return &ZoneResponse{Zone: zonename, Type: "PRIMARY"}, nil
+		},
+		SubmitChangelistFunc: func(zone *ZoneCreate) error {
+			return boom
+		},
+	}
+	var zones ZoneService = fake
+	var records RecordsetService = fake
+
+	zone, err := zones.GetZone("example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "PRIMARY", zone.Type)
+
+	create := NewZone(ZoneCreate{Zone: "example.com", Type: "primary"})
+	assert.Equal(t, boom, zones.SubmitChangelist(create))
+
+	record := &RecordBody{Name: "www.example.com", RecordType: "A", TTL: 300, Target: []string{"192.0.2.1"}}
+	assert.NoError(t, records.SaveRecord(record, "example.com"))
+
+	assert.Equal(t, []FakeCall{
+		{Method: "GetZone", Args: []interface{}{"example.com"}},
+		{Method: "SubmitChangelist", Args: []interface{}{create}},
+		{Method: "SaveRecord", Args: []interface{}{record, "example.com", []bool(nil)}},
+	}, fake.Calls())
+}
+
+func TestFake_IterZones(t *testing.T) {
+	fake := &Fake{
+		ListZonesFunc: func(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
+			page := queryArgs[0].Page
+			metadata := &ListMetadata{Page: page, PageSize: 1, TotalElements: 2}
+			return &ZoneListResponse{Metadata: metadata, Zones: []*ZoneResponse{{Zone: []string{"example.com", "example.net"}[page-1]}}}, nil
+		},
+	}
+
+	var names []string
+	assert.NoError(t, fake.IterZones(ZoneListQueryArgs{PageSize: 1}).ForEach(func(zone *ZoneResponse) error {
+		names = append(names, zone.Zone)
+		return nil
+	}))
+	assert.Equal(t, []string{"example.com", "example.net"}, names)
+	assert.Len(t, fake.Calls(), 3)
+
+	// Without a GetRecordsets, there are no recordsets to walk
+	assert.False(t, (&Fake{}).IterRecordsets("example.com").Next())
+}
@@ -0,0 +1,62 @@
+package dnsv2
+
+import (
+	"strings"
+	"sync"
+)
+
+// zoneLocks serializes the writes to each zone
+var zoneLocks = &keyedMutex{}
+
+// keyedMutex is a set of mutexes, one per key, kept while in use
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*refMutex
+}
+
+type refMutex struct {
+	sync.Mutex
+	refs int
+}
+
+// lockZone locks the writes to zone, returning the function unlocking them.
+// The Soa.Serial of a zone is incremented for every change, so the changes to
+// a zone are saved one at a time, while the writes to other zones are not
+// blocked.
+func lockZone(zone string) func() {
+	return zoneLocks.lock(strings.ToLower(strings.TrimSuffix(zone, ".")))
+}
+
+// lock locks the mutex of key, returning the function unlocking it
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = make(map[string]*refMutex)
+	}
+	m, ok := k.locks[key]
+	if !ok {
+		m = &refMutex{}
+		k.locks[key] = m
+	}
+	m.refs++
+	k.mu.Unlock()
+
+	m.Lock()
+	return func() {
+		m.Unlock()
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		m.refs--
+		if m.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
+
+// len returns the number of keys locked or waited for
+func (k *keyedMutex) len() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return len(k.locks)
+}
@@ -0,0 +1,51 @@
+package dnsv2
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLockZone_SerializesWritesPerZone(t *testing.T) {
+	var wg sync.WaitGroup
+	var holders, maxHolders int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer lockZone("Example.com.")()
+			n := atomic.AddInt32(&holders, 1)
+			for {
+				max := atomic.LoadInt32(&maxHolders)
+				if n <= max || atomic.CompareAndSwapInt32(&maxHolders, max, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&holders, -1)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxHolders)
+	assert.Equal(t, 0, zoneLocks.len())
+}
+
+func TestLockZone_OtherZonesNotBlocked(t *testing.T) {
+	unlock := lockZone("example.com")
+	defer unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer lockZone("example.net")()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("writes to example.net blocked by example.com")
+	}
+}
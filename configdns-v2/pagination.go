@@ -0,0 +1,183 @@
+package dnsv2
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+)
+
+// ZoneIterator walks the zones of all the pages of a zone list. Set Prefetch
+// before the first call to Next to fetch each page while the previous one is walked.
+type ZoneIterator struct {
+	*client.Pager
+}
+
+// Value returns the current zone
+func (it *ZoneIterator) Value() *ZoneResponse {
+	zone, _ := it.Pager.Value().(*ZoneResponse)
+	return zone
+}
+
+// ForEach calls fn with each zone, stopping at the first error
+func (it *ZoneIterator) ForEach(fn func(zone *ZoneResponse) error) error {
+	return it.Pager.ForEach(func(item interface{}) error {
+		return fn(item.(*ZoneResponse))
+	})
+}
+
+// IterZones returns an iterator over the zones listed by ListZones with
+// queryArgs, fetching the pages as needed. The Page of queryArgs is ignored.
+func (c *Client) IterZones(queryArgs ...ZoneListQueryArgs) *ZoneIterator {
+	return iterZones(c, queryArgs...)
+}
+
+// iterZones returns an iterator over the zones listed by the ListZones of zones
+func iterZones(zones ZoneService, queryArgs ...ZoneListQueryArgs) *ZoneIterator {
+	args := ZoneListQueryArgs{}
+	if len(queryArgs) > 0 {
+		args = queryArgs[0]
+	}
+
+	return &ZoneIterator{client.NewPager(func(page int) ([]interface{}, bool, error) {
+		args := args
+		args.Page = page
+		if args.ShowAll {
+			args.Page = 0
+		}
+		list, err := zones.ListZones(args)
+		if err != nil {
+			return nil, false, err
+		}
+		if list == nil {
+			return nil, true, nil
+		}
+
+		items := make([]interface{}, len(list.Zones))
+		for i, zone := range list.Zones {
+			items[i] = zone
+		}
+		last := list.Metadata == nil || list.Metadata.ShowAll ||
+			client.LastPage(page, list.Metadata.PageSize, list.Metadata.TotalElements)
+		return items, last, nil
+	})}
+}
+
+// IterZones returns an iterator over the zones listed by ListZones
+//
+// See: Client.IterZones()
+func IterZones(queryArgs ...ZoneListQueryArgs) *ZoneIterator {
+	return defaultClient.IterZones(queryArgs...)
+}
+
+// RecordsetIterator walks the recordsets of all the pages of a zone. Set Prefetch
+// before the first call to Next to fetch each page while the previous one is walked.
+type RecordsetIterator struct {
+	*client.Pager
+}
+
+// Value returns the current recordset
+func (it *RecordsetIterator) Value() Recordset {
+	recordset, _ := it.Pager.Value().(Recordset)
+	return recordset
+}
+
+// ForEach calls fn with each recordset, stopping at the first error
+func (it *RecordsetIterator) ForEach(fn func(recordset Recordset) error) error {
+	return it.Pager.ForEach(func(item interface{}) error {
+		return fn(item.(Recordset))
+	})
+}
+
+// IterRecordsets returns an iterator over the recordsets of zone returned by
+// GetRecordsets with queryArgs, fetching the pages as needed. The Page of
+// queryArgs is ignored.
+func (c *Client) IterRecordsets(zone string, queryArgs ...RecordsetQueryArgs) *RecordsetIterator {
+	return iterRecordsets(c, zone, queryArgs...)
+}
+
+// iterRecordsets returns an iterator over the recordsets of zone returned by
+// the GetRecordsets of recordsets
+func iterRecordsets(recordsets RecordsetService, zone string, queryArgs ...RecordsetQueryArgs) *RecordsetIterator {
+	args := RecordsetQueryArgs{}
+	if len(queryArgs) > 0 {
+		args = queryArgs[0]
+	}
+
+	return &RecordsetIterator{client.NewPager(func(page int) ([]interface{}, bool, error) {
+		args := args
+		args.Page = page
+		if args.ShowAll {
+			args.Page = 0
+		}
+		list, err := recordsets.GetRecordsets(zone, args)
+		if err != nil {
+			return nil, false, err
+		}
+		if list == nil {
+			return nil, true, nil
+		}
+
+		items := make([]interface{}, len(list.Recordsets))
+		for i, recordset := range list.Recordsets {
+			items[i] = recordset
+		}
+		metadata := list.Metadata
+		last := metadata.ShowAll || client.LastPage(page, metadata.PageSize, metadata.TotalElements)
+		if metadata.LastPage > 0 {
+			last = metadata.ShowAll || page >= metadata.LastPage
+		}
+		return items, last, nil
+	})}
+}
+
+// IterRecordsets returns an iterator over the recordsets of zone
+//
+// See: Client.IterRecordsets()
+func IterRecordsets(zone string, queryArgs ...RecordsetQueryArgs) *RecordsetIterator {
+	return defaultClient.IterRecordsets(zone, queryArgs...)
+}
+
+// TsigKeyIterator walks the TSIG keys listed by ListTsigKeys
+type TsigKeyIterator struct {
+	*client.Pager
+}
+
+// Value returns the current TSIG key
+func (it *TsigKeyIterator) Value() *TSIGKeyResponse {
+	key, _ := it.Pager.Value().(*TSIGKeyResponse)
+	return key
+}
+
+// ForEach calls fn with each TSIG key, stopping at the first error
+func (it *TsigKeyIterator) ForEach(fn func(key *TSIGKeyResponse) error) error {
+	return it.Pager.ForEach(func(item interface{}) error {
+		return fn(item.(*TSIGKeyResponse))
+	})
+}
+
+// IterTsigKeys returns an iterator over the TSIG keys listed by ListTsigKeys
+// with tsigquerystring, which may be nil. The API is not paged, so all the
+// keys come in a single page fetched by the first call to Next.
+func (c *Client) IterTsigKeys(tsigquerystring *TSIGQueryString) *TsigKeyIterator {
+	if tsigquerystring == nil {
+		tsigquerystring = NewTSIGQueryString()
+	}
+
+	return &TsigKeyIterator{client.NewPager(func(page int) ([]interface{}, bool, error) {
+		list, err := c.ListTsigKeys(tsigquerystring)
+		if err != nil {
+			return nil, false, err
+		}
+
+		items := make([]interface{}, len(list.Keys))
+		for i, key := range list.Keys {
+			items[i] = key
+		}
+		return items, true, nil
+	})}
+}
+
+// IterTsigKeys returns an iterator over the TSIG keys listed by ListTsigKeys
+//
+// See: Client.IterTsigKeys()
+func IterTsigKeys(tsigquerystring *TSIGQueryString) *TsigKeyIterator {
+	return defaultClient.IterTsigKeys(tsigquerystring)
+}
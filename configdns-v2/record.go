@@ -3,8 +3,6 @@ package dnsv2
 import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
-
-	"sync"
 )
 
 // The record types implemented and their fields are as defined here
@@ -55,10 +53,6 @@ type RecordBody struct {
 	PriorityIncrement   int    `json:"priority_increment,omitempty"`     //MX priority Increment
 }
 
-var (
-	zoneRecordWriteLock sync.Mutex
-)
-
 func (record *RecordBody) ToMap() map[string]interface{} {
 	return map[string]interface{}{
 		"name":   record.Name,
@@ -86,19 +80,16 @@ func localLock(lockArg []bool) bool {
 }
 
 func (record *RecordBody) Save(zone string, recLock ...bool) error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
+	return defaultClient.SaveRecord(record, zone, recLock...)
+}
 
+// SaveRecord creates record in zone
+func (c *Client) SaveRecord(record *RecordBody, zone string, recLock ...bool) error {
 	if localLock(recLock) {
-		zoneRecordWriteLock.Lock()
-		defer zoneRecordWriteLock.Unlock()
+		defer lockZone(zone)()
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/config-dns/v2/zones/"+zone+"/names/"+record.Name+"/types/"+record.RecordType,
 		record,
@@ -109,7 +100,7 @@ func (record *RecordBody) Save(zone string, recLock ...bool) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -132,19 +123,16 @@ func (record *RecordBody) Save(zone string, recLock ...bool) error {
 }
 
 func (record *RecordBody) Update(zone string, recLock ...bool) error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
+	return defaultClient.UpdateRecord(record, zone, recLock...)
+}
 
+// UpdateRecord updates record in zone
+func (c *Client) UpdateRecord(record *RecordBody, zone string, recLock ...bool) error {
 	if localLock(recLock) {
-		zoneRecordWriteLock.Lock()
-		defer zoneRecordWriteLock.Unlock()
+		defer lockZone(zone)()
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		"/config-dns/v2/zones/"+zone+"/names/"+record.Name+"/types/"+record.RecordType,
 		record,
@@ -155,7 +143,7 @@ func (record *RecordBody) Update(zone string, recLock ...bool) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -178,19 +166,16 @@ func (record *RecordBody) Update(zone string, recLock ...bool) error {
 }
 
 func (record *RecordBody) Delete(zone string, recLock ...bool) error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
+	return defaultClient.DeleteRecord(record, zone, recLock...)
+}
 
+// DeleteRecord deletes record from zone
+func (c *Client) DeleteRecord(record *RecordBody, zone string, recLock ...bool) error {
 	if localLock(recLock) {
-		zoneRecordWriteLock.Lock()
-		defer zoneRecordWriteLock.Unlock()
+		defer lockZone(zone)()
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"DELETE",
 		"/config-dns/v2/zones/"+zone+"/names/"+record.Name+"/types/"+record.RecordType,
 		nil,
@@ -201,7 +186,7 @@ func (record *RecordBody) Delete(zone string, recLock ...bool) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
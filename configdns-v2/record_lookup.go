@@ -80,12 +80,11 @@ func PadCoordinates(str string) string {
 }
 
 // Get single Recordset. Following convention for other single record CRUD operations, return a RecordBody.
-func GetRecord(zone string, name string, record_type string) (*RecordBody, error) {
+func (c *Client) GetRecord(zone string, name string, record_type string) (*RecordBody, error) {
 
 	record := &RecordBody{}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-dns/v2/zones/%s/names/%s/types/%s", zone, name, record_type),
 		nil,
@@ -96,7 +95,7 @@ func GetRecord(zone string, name string, record_type string) (*RecordBody, error
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -116,12 +115,18 @@ func GetRecord(zone string, name string, record_type string) (*RecordBody, error
 	}
 }
 
-func GetRecordList(zone string, name string, record_type string) (*RecordSetResponse, error) {
+// Get single Recordset. Following convention for other single record CRUD operations, return a RecordBody.
+//
+// See: Client.GetRecord()
+func GetRecord(zone string, name string, record_type string) (*RecordBody, error) {
+	return defaultClient.GetRecord(zone, name, record_type)
+}
+
+func (c *Client) GetRecordList(zone string, name string, record_type string) (*RecordSetResponse, error) {
 
 	records := NewRecordSetResponse(name)
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		"/config-dns/v2/zones/"+zone+"/recordsets?types="+record_type+"&showAll=true",
 		nil,
@@ -132,7 +137,7 @@ func GetRecordList(zone string, name string, record_type string) (*RecordSetResp
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -152,8 +157,12 @@ func GetRecordList(zone string, name string, record_type string) (*RecordSetResp
 	}
 }
 
-func GetRdata(zone string, name string, record_type string) ([]string, error) {
-	records, err := GetRecordList(zone, name, record_type)
+func GetRecordList(zone string, name string, record_type string) (*RecordSetResponse, error) {
+	return defaultClient.GetRecordList(zone, name, record_type)
+}
+
+func (c *Client) GetRdata(zone string, name string, record_type string) ([]string, error) {
+	records, err := c.GetRecordList(zone, name, record_type)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +195,10 @@ func GetRdata(zone string, name string, record_type string) ([]string, error) {
 	return rdata, nil
 }
 
+func GetRdata(zone string, name string, record_type string) ([]string, error) {
+	return defaultClient.GetRdata(zone, name, record_type)
+}
+
 func ProcessRdata(rdata []string, rtype string) []string {
 
 	newrdata := make([]string, 0, len(rdata))
@@ -6,11 +6,6 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 	"strconv"
-	"sync"
-)
-
-var (
-	zoneRecordsetsWriteLock sync.Mutex
 )
 
 // Recordset Query args struct
@@ -54,7 +49,7 @@ func NewRecordSetResponse(name string) *RecordSetResponse {
 }
 
 // Get RecordSets with Query Args. No formatting of arg values!
-func GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResponse, error) {
+func (c *Client) GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResponse, error) {
 
 	recordsetResp := NewRecordSetResponse("")
 
@@ -64,8 +59,7 @@ func GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResp
 		return nil, errors.New("GetRecordsets QueryArgs invalid.")
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		getURL,
 		nil,
@@ -97,7 +91,7 @@ func GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResp
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -117,21 +111,27 @@ func GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResp
 	}
 }
 
+// Get RecordSets with Query Args. No formatting of arg values!
+//
+// See: Client.GetRecordsets()
+func GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResponse, error) {
+	return defaultClient.GetRecordsets(zone, queryArgs...)
+}
+
 // Create Recordstes
+//
+// See: Client.SaveRecordsets()
 func (recordsets *Recordsets) Save(zone string, recLock ...bool) error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
+	return defaultClient.SaveRecordsets(recordsets, zone, recLock...)
+}
 
+// Create Recordstes
+func (c *Client) SaveRecordsets(recordsets *Recordsets, zone string, recLock ...bool) error {
 	if localLock(recLock) {
-		zoneRecordsetsWriteLock.Lock()
-		defer zoneRecordsetsWriteLock.Unlock()
+		defer lockZone(zone)()
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/config-dns/v2/zones/"+zone+"/recordsets",
 		recordsets,
@@ -142,7 +142,7 @@ func (recordsets *Recordsets) Save(zone string, recLock ...bool) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -165,19 +165,15 @@ func (recordsets *Recordsets) Save(zone string, recLock ...bool) error {
 }
 
 func (recordsets *Recordsets) Update(zone string, recLock ...bool) error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
+	return defaultClient.UpdateRecordsets(recordsets, zone, recLock...)
+}
 
+func (c *Client) UpdateRecordsets(recordsets *Recordsets, zone string, recLock ...bool) error {
 	if localLock(recLock) {
-		zoneRecordsetsWriteLock.Lock()
-		defer zoneRecordsetsWriteLock.Unlock()
+		defer lockZone(zone)()
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		"/config-dns/v2/zones/"+zone+"/recordsets",
 		recordsets,
@@ -188,7 +184,7 @@ func (recordsets *Recordsets) Update(zone string, recLock ...bool) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
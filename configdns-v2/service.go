@@ -1,6 +1,8 @@
 package dnsv2
 
 import (
+	"context"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 )
 
@@ -8,11 +10,61 @@ var (
 	// Config contains the Akamai OPEN Edgegrid API credentials
 	// for automatic signing of requests
 	Config edgegrid.Config
+
+	// defaultClient is used by the package-level functions and methods, with Config
+	defaultClient = New(client.NewLiveSession(&Config))
 )
 
+// Client is a DNSv2 client bound to a client.Session
+type Client struct {
+	session *client.Session
+}
+
+// New creates a new DNSv2 Client using session
+func New(session *client.Session) *Client {
+	return &Client{session: session}
+}
+
+// WithContext returns a copy of the Client making its requests with ctx
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return New(c.session.WithContext(ctx))
+}
+
 // Init sets the DNSv2 edgegrid Config
 func Init(config edgegrid.Config) {
 	Config = config
 	edgegrid.SetupLogging()
 
 }
+
+// ZoneService describes the zone operations of a Client, for consumers to
+// depend on instead of the Client, and to substitute a Fake in their tests
+type ZoneService interface {
+	ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error)
+	IterZones(queryArgs ...ZoneListQueryArgs) *ZoneIterator
+	GetZone(zonename string) (*ZoneResponse, error)
+	SaveZone(zone *ZoneCreate, zonequerystring ZoneQueryString, clearConn ...bool) error
+	UpdateZone(zone *ZoneCreate, zonequerystring ZoneQueryString) error
+	DeleteZone(zone *ZoneCreate, zonequerystring ZoneQueryString) error
+	SaveChangelist(zone *ZoneCreate) error
+	SubmitChangelist(zone *ZoneCreate) error
+	GetZoneNames(zone string) (*ZoneNamesResponse, error)
+	GetZoneNameTypes(zname string, zone string) (*ZoneNameTypesResponse, error)
+}
+
+// RecordsetService describes the recordset and record operations of a Client
+type RecordsetService interface {
+	GetRecordsets(zone string, queryArgs ...RecordsetQueryArgs) (*RecordSetResponse, error)
+	IterRecordsets(zone string, queryArgs ...RecordsetQueryArgs) *RecordsetIterator
+	SaveRecordsets(recordsets *Recordsets, zone string, recLock ...bool) error
+	UpdateRecordsets(recordsets *Recordsets, zone string, recLock ...bool) error
+	GetRecord(zone string, name string, record_type string) (*RecordBody, error)
+	SaveRecord(record *RecordBody, zone string, recLock ...bool) error
+	UpdateRecord(record *RecordBody, zone string, recLock ...bool) error
+	DeleteRecord(record *RecordBody, zone string, recLock ...bool) error
+}
+
+var (
+	_ ZoneService      = (*Client)(nil)
+	_ RecordsetService = (*Client)(nil)
+)
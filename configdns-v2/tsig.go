@@ -6,11 +6,6 @@ import (
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 	"reflect"
 	"strings"
-	"sync"
-)
-
-var (
-	tsigWriteLock sync.Mutex
 )
 
 // TODO: Add examples?
@@ -120,11 +115,10 @@ func constructTsigQueryString(tsigquerystring *TSIGQueryString) string {
 }
 
 // List TSIG Keys
-func ListTsigKeys(tsigquerystring *TSIGQueryString) (*TSIGReportResponse, error) {
+func (c *Client) ListTsigKeys(tsigquerystring *TSIGQueryString) (*TSIGReportResponse, error) {
 
 	tsigList := &TSIGReportResponse{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-dns/v2/keys%s", constructTsigQueryString(tsigquerystring)),
 		nil,
@@ -135,7 +129,7 @@ func ListTsigKeys(tsigquerystring *TSIGQueryString) (*TSIGReportResponse, error)
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -163,12 +157,25 @@ func ListTsigKeys(tsigquerystring *TSIGQueryString) (*TSIGReportResponse, error)
 
 }
 
+// List TSIG Keys
+//
+// See: Client.ListTsigKeys()
+func ListTsigKeys(tsigquerystring *TSIGQueryString) (*TSIGReportResponse, error) {
+	return defaultClient.ListTsigKeys(tsigquerystring)
+}
+
 // GetZones retrieves DNS Zones using tsig key
+//
+// See: Client.GetTsigKeyZones()
 func (tsigKey *TSIGKey) GetZones() (*ZoneNameListResponse, error) {
+	return defaultClient.GetTsigKeyZones(tsigKey)
+}
+
+// GetZones retrieves DNS Zones using tsig key
+func (c *Client) GetTsigKeyZones(tsigKey *TSIGKey) (*ZoneNameListResponse, error) {
 
 	zonesList := &ZoneNameListResponse{}
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/config-dns/v2/keys/used-by",
 		tsigKey,
@@ -179,7 +186,7 @@ func (tsigKey *TSIGKey) GetZones() (*ZoneNameListResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -207,12 +214,11 @@ func (tsigKey *TSIGKey) GetZones() (*ZoneNameListResponse, error) {
 // There is a discrepency between the technical doc and API operation. API currently returns a zone name list.
 // TODO: Reconcile
 //
-func GetZoneKeyAliases(zone string) (*ZoneNameListResponse, error) {
+func (c *Client) GetZoneKeyAliases(zone string) (*ZoneNameListResponse, error) {
 
 	zonesList := &ZoneNameListResponse{}
 	//zoneAliases :=&TSIGZoneAliases{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-dns/v2/zones/%s/key/used-by", zone),
 		nil,
@@ -223,7 +229,7 @@ func GetZoneKeyAliases(zone string) (*ZoneNameListResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -247,11 +253,24 @@ func GetZoneKeyAliases(zone string) (*ZoneNameListResponse, error) {
 	}
 }
 
+// GetZoneKeyAliases retrieves a DNS Zone's aliases
+//
+// See: Client.GetZoneKeyAliases()
+func GetZoneKeyAliases(zone string) (*ZoneNameListResponse, error) {
+	return defaultClient.GetZoneKeyAliases(zone)
+}
+
 // Bulk Zones tsig key update
+//
+// See: Client.BulkUpdateTsigKeys()
 func (tsigBulk *TSIGKeyBulkPost) BulkUpdate() error {
+	return defaultClient.BulkUpdateTsigKeys(tsigBulk)
+}
+
+// Bulk Zones tsig key update
+func (c *Client) BulkUpdateTsigKeys(tsigBulk *TSIGKeyBulkPost) error {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/config-dns/v2/keys/bulk-update",
 		tsigBulk,
@@ -262,7 +281,7 @@ func (tsigBulk *TSIGKeyBulkPost) BulkUpdate() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	edge.PrintHttpResponse(res, true)
 
@@ -285,11 +304,10 @@ func (tsigBulk *TSIGKeyBulkPost) BulkUpdate() error {
 }
 
 // GetZoneKey retrieves a DNS Zone's key
-func GetZoneKey(zone string) (*TSIGKeyResponse, error) {
+func (c *Client) GetZoneKey(zone string) (*TSIGKeyResponse, error) {
 
 	zonekey := &TSIGKeyResponse{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-dns/v2/zones/%s/key", zone),
 		nil,
@@ -300,7 +318,7 @@ func GetZoneKey(zone string) (*TSIGKeyResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -322,11 +340,17 @@ func GetZoneKey(zone string) (*TSIGKeyResponse, error) {
 	}
 }
 
+// GetZoneKey retrieves a DNS Zone's key
+//
+// See: Client.GetZoneKey()
+func GetZoneKey(zone string) (*TSIGKeyResponse, error) {
+	return defaultClient.GetZoneKey(zone)
+}
+
 // Delete tsig key for zone
-func DeleteZoneKey(zone string) error {
+func (c *Client) DeleteZoneKey(zone string) error {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-dns/v2/zones/%s/key", zone),
 		nil,
@@ -337,7 +361,7 @@ func DeleteZoneKey(zone string) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -360,11 +384,24 @@ func DeleteZoneKey(zone string) error {
 
 }
 
+// Delete tsig key for zone
+//
+// See: Client.DeleteZoneKey()
+func DeleteZoneKey(zone string) error {
+	return defaultClient.DeleteZoneKey(zone)
+}
+
 // Update tsig key for zone
+//
+// See: Client.UpdateZoneKey()
 func (tsigKey *TSIGKey) Update(zone string) error {
+	return defaultClient.UpdateZoneKey(tsigKey, zone)
+}
+
+// Update tsig key for zone
+func (c *Client) UpdateZoneKey(tsigKey *TSIGKey, zone string) error {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-dns/v2/zones/%s/key", zone),
 		tsigKey,
@@ -375,7 +412,7 @@ func (tsigKey *TSIGKey) Update(zone string) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	// Network error
 	if err != nil {
 		return &TsigError{
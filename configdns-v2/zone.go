@@ -6,15 +6,9 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 	"io/ioutil"
-	"log"
 	"reflect"
 	"strconv"
 	"strings"
-	"sync"
-)
-
-var (
-	zoneWriteLock sync.Mutex
 )
 
 // Zone represents a DNS zone
@@ -155,7 +149,7 @@ type ZoneNameTypesResponse struct {
 }
 
 // List Zones
-func ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
+func (c *Client) ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
 
 	zoneListResp := &ZoneListResponse{}
 
@@ -165,8 +159,7 @@ func ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
 		return nil, fmt.Errorf("ListZones QueryArgs invalid.")
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		getURL,
 		nil,
@@ -201,7 +194,7 @@ func ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +212,13 @@ func ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
 	}
 }
 
+// List Zones
+//
+// See: Client.ListZones()
+func ListZones(queryArgs ...ZoneListQueryArgs) (*ZoneListResponse, error) {
+	return defaultClient.ListZones(queryArgs...)
+}
+
 // NewZone creates a new Zone. Supports subset of fields
 func NewZone(params ZoneCreate) *ZoneCreate {
 	zone := &ZoneCreate{Zone: params.Zone,
@@ -250,10 +250,9 @@ func NewZoneQueryString(Contract string, group string) *ZoneQueryString {
 }
 
 // GetZone retrieves a DNS Zone for a given hostname
-func GetZone(zonename string) (*ZoneResponse, error) {
+func (c *Client) GetZone(zonename string) (*ZoneResponse, error) {
 	zone := NewZoneResponse(zonename)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		//"/config-dns/v2/zones/"+zone.Zone,
 		"/config-dns/v2/zones/"+zonename,
@@ -265,7 +264,7 @@ func GetZone(zonename string) (*ZoneResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -287,10 +286,16 @@ func GetZone(zonename string) (*ZoneResponse, error) {
 }
 
 // GetZone retrieves a DNS Zone for a given hostname
-func GetChangeList(zone string) (*ChangeListResponse, error) {
+//
+// See: Client.GetZone()
+func GetZone(zonename string) (*ZoneResponse, error) {
+	return defaultClient.GetZone(zonename)
+}
+
+// GetZone retrieves a DNS Zone for a given hostname
+func (c *Client) GetChangeList(zone string) (*ChangeListResponse, error) {
 	changelist := NewChangeListResponse(zone)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		"/config-dns/v2/changelists/"+zone,
 		nil,
@@ -301,7 +306,7 @@ func GetChangeList(zone string) (*ChangeListResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -323,10 +328,16 @@ func GetChangeList(zone string) (*ChangeListResponse, error) {
 }
 
 // GetZone retrieves a DNS Zone for a given hostname
-func GetMasterZoneFile(zone string) (string, error) {
+//
+// See: Client.GetChangeList()
+func GetChangeList(zone string) (*ChangeListResponse, error) {
+	return defaultClient.GetChangeList(zone)
+}
+
+// GetZone retrieves a DNS Zone for a given hostname
+func (c *Client) GetMasterZoneFile(zone string) (string, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		"/config-dns/v2/zones/"+zone+"/zone-file",
 		nil,
@@ -338,9 +349,11 @@ func GetMasterZoneFile(zone string) (string, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
-		log.Printf("[DEBUG] [Akamai LIB] ZM %v %v", res, err)
+		fields := edge.RequestLogFields(req)
+		fields[edge.LogFieldError] = err
+		edge.LoggerFromContext(req.Context()).Debug("Zone file request failed", fields)
 		return "", err
 	}
 
@@ -361,12 +374,18 @@ func GetMasterZoneFile(zone string) (string, error) {
 	}
 }
 
+// GetZone retrieves a DNS Zone for a given hostname
+//
+// See: Client.GetMasterZoneFile()
+func GetMasterZoneFile(zone string) (string, error) {
+	return defaultClient.GetMasterZoneFile(zone)
+}
+
 // Update Master Zone file
-func PostMasterZoneFile(zone string, filedata string) error {
+func (c *Client) PostMasterZoneFile(zone string, filedata string) error {
 
 	buf := bytes.NewReader([]byte(filedata))
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"POST",
 		fmt.Sprintf("/config-dns/v2/zones/%s/zone-file", zone),
 		buf,
@@ -379,7 +398,7 @@ func PostMasterZoneFile(zone string, filedata string) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -401,23 +420,30 @@ func PostMasterZoneFile(zone string, filedata string) error {
 	return nil
 }
 
+// Update Master Zone file
+//
+// See: Client.PostMasterZoneFile()
+func PostMasterZoneFile(zone string, filedata string) error {
+	return defaultClient.PostMasterZoneFile(zone, filedata)
+}
+
 // Create a Zone
+//
+// See: Client.SaveZone()
 func (zone *ZoneCreate) Save(zonequerystring ZoneQueryString, clearConn ...bool) error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
-	zoneWriteLock.Lock()
-	defer zoneWriteLock.Unlock()
+	return defaultClient.SaveZone(zone, zonequerystring, clearConn...)
+}
+
+// Create a Zone
+func (c *Client) SaveZone(zone *ZoneCreate, zonequerystring ZoneQueryString, clearConn ...bool) error {
+	defer lockZone(zone.Zone)()
 
 	zoneMap := filterZoneCreate(zone)
 	zoneurl := "/config-dns/v2/zones/?contractId=" + zonequerystring.Contract
 	if len(zonequerystring.Group) > 0 {
 		zoneurl += "&gid=" + zonequerystring.Group
 	}
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		zoneurl,
 		zoneMap,
@@ -428,7 +454,7 @@ func (zone *ZoneCreate) Save(zonequerystring ZoneQueryString, clearConn ...bool)
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -452,8 +478,8 @@ func (zone *ZoneCreate) Save(zonequerystring ZoneQueryString, clearConn ...bool)
 		for _, clear := range clearConn {
 			// should only be one entry
 			if clear {
-				edge.LogMultiline(edge.EdgegridLog.Traceln, "Clearing Idle Connections")
-				client.Client.CloseIdleConnections()
+				edge.LoggerFromContext(req.Context()).Debug("Clearing Idle Connections", edge.Fields{edge.LogFieldService: "config-dns"})
+				c.session.CloseIdleConnections()
 			}
 		}
 	}
@@ -462,15 +488,17 @@ func (zone *ZoneCreate) Save(zonequerystring ZoneQueryString, clearConn ...bool)
 }
 
 // Create changelist for the Zone. Side effect is to create default NS SOA records
+//
+// See: Client.SaveChangelist()
 func (zone *ZoneCreate) SaveChangelist() error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
-
-	req, err := client.NewJSONRequest(
-		Config,
+	return defaultClient.SaveChangelist(zone)
+}
+
+// Create changelist for the Zone. Side effect is to create default NS SOA records
+func (c *Client) SaveChangelist(zone *ZoneCreate) error {
+	defer lockZone(zone.Zone)()
+
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/config-dns/v2/changelists/?zone="+zone.Zone,
 		"",
@@ -481,7 +509,7 @@ func (zone *ZoneCreate) SaveChangelist() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -504,15 +532,17 @@ func (zone *ZoneCreate) SaveChangelist() error {
 }
 
 // Save changelist for the Zone to create default NS SOA records
+//
+// See: Client.SubmitChangelist()
 func (zone *ZoneCreate) SubmitChangelist() error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
-
-	req, err := client.NewJSONRequest(
-		Config,
+	return defaultClient.SubmitChangelist(zone)
+}
+
+// Save changelist for the Zone to create default NS SOA records
+func (c *Client) SubmitChangelist(zone *ZoneCreate) error {
+	defer lockZone(zone.Zone)()
+
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/config-dns/v2/changelists/"+zone.Zone+"/submit",
 		"",
@@ -523,7 +553,7 @@ func (zone *ZoneCreate) SubmitChangelist() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -546,16 +576,18 @@ func (zone *ZoneCreate) SubmitChangelist() error {
 }
 
 // Save updates the Zone
+//
+// See: Client.UpdateZone()
 func (zone *ZoneCreate) Update(zonequerystring ZoneQueryString) error {
-	// This lock will restrict the concurrency of API calls
-	// to 1 save request at a time. This is needed for the Soa.Serial value which
-	// is required to be incremented for every subsequent update to a zone
-	// so we have to save just one request at a time to ensure this is always
-	// incremented properly
+	return defaultClient.UpdateZone(zone, zonequerystring)
+}
+
+// Save updates the Zone
+func (c *Client) UpdateZone(zone *ZoneCreate, zonequerystring ZoneQueryString) error {
+	defer lockZone(zone.Zone)()
 
 	zoneMap := filterZoneCreate(zone)
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		"/config-dns/v2/zones/"+zone.Zone,
 		zoneMap,
@@ -566,7 +598,7 @@ func (zone *ZoneCreate) Update(zonequerystring ZoneQueryString) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -589,11 +621,14 @@ func (zone *ZoneCreate) Update(zonequerystring ZoneQueryString) error {
 }
 
 func (zone *ZoneCreate) Delete(zonequerystring ZoneQueryString) error {
+	return defaultClient.DeleteZone(zone, zonequerystring)
+}
+
+func (c *Client) DeleteZone(zone *ZoneCreate, zonequerystring ZoneQueryString) error {
 	// remove all the records except for SOA
 	// which is required and save the zone
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"DELETE",
 		"/config-dns/v2/zones/"+zone.Zone,
 		nil,
@@ -604,7 +639,7 @@ func (zone *ZoneCreate) Delete(zonequerystring ZoneQueryString) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -709,11 +744,10 @@ func ValidateZone(zone *ZoneCreate) error {
 }
 
 // Get Zone's Names
-func GetZoneNames(zone string) (*ZoneNamesResponse, error) {
+func (c *Client) GetZoneNames(zone string) (*ZoneNamesResponse, error) {
 
 	zoneNameResponse := &ZoneNamesResponse{Names: make([]string, 0)}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-dns/v2/zones/%s/names", zone),
 		nil,
@@ -724,7 +758,7 @@ func GetZoneNames(zone string) (*ZoneNamesResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -745,12 +779,18 @@ func GetZoneNames(zone string) (*ZoneNamesResponse, error) {
 	}
 }
 
+// Get Zone's Names
+//
+// See: Client.GetZoneNames()
+func GetZoneNames(zone string) (*ZoneNamesResponse, error) {
+	return defaultClient.GetZoneNames(zone)
+}
+
 // Get Zone Name's record types
-func GetZoneNameTypes(zname string, zone string) (*ZoneNameTypesResponse, error) {
+func (c *Client) GetZoneNameTypes(zname string, zone string) (*ZoneNameTypesResponse, error) {
 
 	zoneNameTypesResponse := &ZoneNameTypesResponse{Types: make([]string, 0)}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-dns/v2/zones/%s/names/%s/types", zone, zname),
 		nil,
@@ -761,7 +801,7 @@ func GetZoneNameTypes(zname string, zone string) (*ZoneNameTypesResponse, error)
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -781,3 +821,10 @@ func GetZoneNameTypes(zname string, zone string) (*ZoneNameTypesResponse, error)
 		return zoneNameTypesResponse, nil
 	}
 }
+
+// Get Zone Name's record types
+//
+// See: Client.GetZoneNameTypes()
+func GetZoneNameTypes(zname string, zone string) (*ZoneNameTypesResponse, error) {
+	return defaultClient.GetZoneNameTypes(zname, zone)
+}
@@ -1,10 +1,14 @@
 package dnsv2
 
 import (
+	"errors"
 	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/jsonhooks-v1"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
+	"io"
+	"net/http"
 	"testing"
 )
 
@@ -74,3 +78,60 @@ func TestGetZoneNameTypes(t *testing.T) {
 	assert.Equal(t, len(typeList.Types), 2)
 
 }
+
+func TestZoneError_Categories(t *testing.T) {
+
+	defer gock.Off()
+
+	mock := gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/config-dns/v2/zones/example.com")
+	mock.
+		Get("/config-dns/v2/zones/example.com").
+		HeaderPresent("Authorization").
+		Reply(404).
+		SetHeader("Content-Type", "application/json;charset=UTF-8").
+		BodyString(`{}`)
+
+	mock = gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/config-dns/v2/zones/")
+	mock.
+		Post("/config-dns/v2/zones/").
+		HeaderPresent("Authorization").
+		Reply(409).
+		SetHeader("Content-Type", "application/problem+json").
+		BodyString(`{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/conflict", "title": "Conflict", "status": 409, "detail": "Zone example.com already exists"}`)
+
+	Init(config)
+	zone := NewZone(ZoneCreate{Zone: "example.com", Type: "primary"})
+
+	_, err := GetZone("example.com")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+	assert.False(t, client.IsRetryable(err))
+
+	err = zone.Save(ZoneQueryString{Contract: "1-2AB34C"})
+	assert.True(t, errors.Is(err, client.ErrConflict))
+	var apiErr client.APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, 409, apiErr.Status)
+	}
+	assert.True(t, err.(*ZoneError).ConcurrencyConflict())
+	assert.True(t, err.(*ZoneError).ValidationFailed())
+
+	err = &ZoneError{zoneName: "example.com", apiErrorMessage: "Internal error", err: apiError(500, "Internal error")}
+	assert.True(t, err.(*ZoneError).ValidationFailed())
+	assert.True(t, errors.Is(err, client.ErrTransient))
+
+	err = &ZoneError{zoneName: "example.com", apiErrorMessage: "Not Found", err: apiError(404, "Not Found")}
+	assert.True(t, err.(*ZoneError).NotFound())
+
+	err = &ZoneError{zoneName: "example.com", httpErrorMessage: "connection reset", err: io.ErrUnexpectedEOF}
+	assert.True(t, errors.Is(err, client.ErrTransient))
+	assert.True(t, client.IsRetryable(err))
+}
+
+func apiError(status int, detail string) client.APIError {
+	return client.APIError{
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Response: &http.Response{StatusCode: status, Status: fmt.Sprintf("%d %s", status, http.StatusText(status))},
+	}
+}
@@ -62,11 +62,10 @@ type BulkDeleteResultResponse struct {
 }
 
 // Get Bulk Zone Create Status
-func GetBulkZoneCreateStatus(requestid string) (*BulkStatusResponse, error) {
+func (c *Client) GetBulkZoneCreateStatus(requestid string) (*BulkStatusResponse, error) {
 
 	bulkzonesurl := fmt.Sprintf("/config-dns/v2/zones/create-requests/%s", requestid)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		bulkzonesurl,
 		nil,
@@ -77,7 +76,7 @@ func GetBulkZoneCreateStatus(requestid string) (*BulkStatusResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -105,12 +104,18 @@ func GetBulkZoneCreateStatus(requestid string) (*BulkStatusResponse, error) {
 	return bulkresponse, nil
 }
 
+// Get Bulk Zone Create Status
+//
+// See: Client.GetBulkZoneCreateStatus()
+func GetBulkZoneCreateStatus(requestid string) (*BulkStatusResponse, error) {
+	return defaultClient.GetBulkZoneCreateStatus(requestid)
+}
+
 // Get Bulk Zone Delete Status
-func GetBulkZoneDeleteStatus(requestid string) (*BulkStatusResponse, error) {
+func (c *Client) GetBulkZoneDeleteStatus(requestid string) (*BulkStatusResponse, error) {
 
 	bulkzonesurl := fmt.Sprintf("/config-dns/v2/zones/delete-requests/%s", requestid)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		bulkzonesurl,
 		nil,
@@ -121,7 +126,7 @@ func GetBulkZoneDeleteStatus(requestid string) (*BulkStatusResponse, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -149,12 +154,18 @@ func GetBulkZoneDeleteStatus(requestid string) (*BulkStatusResponse, error) {
 	return bulkresponse, nil
 }
 
+// Get Bulk Zone Delete Status
+//
+// See: Client.GetBulkZoneDeleteStatus()
+func GetBulkZoneDeleteStatus(requestid string) (*BulkStatusResponse, error) {
+	return defaultClient.GetBulkZoneDeleteStatus(requestid)
+}
+
 // Get Bulk Zone Create Result
-func GetBulkZoneCreateResult(requestid string) (*BulkCreateResultResponse, error) {
+func (c *Client) GetBulkZoneCreateResult(requestid string) (*BulkCreateResultResponse, error) {
 
 	bulkzonesurl := fmt.Sprintf("/config-dns/v2/zones/create-requests/%s/result", requestid)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		bulkzonesurl,
 		nil,
@@ -165,7 +176,7 @@ func GetBulkZoneCreateResult(requestid string) (*BulkCreateResultResponse, error
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -193,12 +204,18 @@ func GetBulkZoneCreateResult(requestid string) (*BulkCreateResultResponse, error
 	return bulkresponse, nil
 }
 
+// Get Bulk Zone Create Result
+//
+// See: Client.GetBulkZoneCreateResult()
+func GetBulkZoneCreateResult(requestid string) (*BulkCreateResultResponse, error) {
+	return defaultClient.GetBulkZoneCreateResult(requestid)
+}
+
 // Get Bulk Zone Delete Result
-func GetBulkZoneDeleteResult(requestid string) (*BulkDeleteResultResponse, error) {
+func (c *Client) GetBulkZoneDeleteResult(requestid string) (*BulkDeleteResultResponse, error) {
 
 	bulkzonesurl := fmt.Sprintf("/config-dns/v2/zones/delete-requests/%s/result", requestid)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		bulkzonesurl,
 		nil,
@@ -209,7 +226,7 @@ func GetBulkZoneDeleteResult(requestid string) (*BulkDeleteResultResponse, error
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -237,15 +254,21 @@ func GetBulkZoneDeleteResult(requestid string) (*BulkDeleteResultResponse, error
 	return bulkresponse, nil
 }
 
+// Get Bulk Zone Delete Result
+//
+// See: Client.GetBulkZoneDeleteResult()
+func GetBulkZoneDeleteResult(requestid string) (*BulkDeleteResultResponse, error) {
+	return defaultClient.GetBulkZoneDeleteResult(requestid)
+}
+
 // Bulk Create Zones
-func CreateBulkZones(bulkzones *BulkZonesCreate, zonequerystring ZoneQueryString) (*BulkZonesResponse, error) {
+func (c *Client) CreateBulkZones(bulkzones *BulkZonesCreate, zonequerystring ZoneQueryString) (*BulkZonesResponse, error) {
 
 	bulkzonesurl := "/config-dns/v2/zones/create-requests?contractId=" + zonequerystring.Contract
 	if len(zonequerystring.Group) > 0 {
 		bulkzonesurl += "&gid=" + zonequerystring.Group
 	}
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		bulkzonesurl,
 		bulkzones,
@@ -256,7 +279,7 @@ func CreateBulkZones(bulkzones *BulkZonesCreate, zonequerystring ZoneQueryString
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -284,16 +307,22 @@ func CreateBulkZones(bulkzones *BulkZonesCreate, zonequerystring ZoneQueryString
 	return bulkresponse, nil
 }
 
+// Bulk Create Zones
+//
+// See: Client.CreateBulkZones()
+func CreateBulkZones(bulkzones *BulkZonesCreate, zonequerystring ZoneQueryString) (*BulkZonesResponse, error) {
+	return defaultClient.CreateBulkZones(bulkzones, zonequerystring)
+}
+
 // Bulk Delete Zones
-func DeleteBulkZones(zoneslist *ZoneNameListResponse, bypassSafetyChecks ...bool) (*BulkZonesResponse, error) {
+func (c *Client) DeleteBulkZones(zoneslist *ZoneNameListResponse, bypassSafetyChecks ...bool) (*BulkZonesResponse, error) {
 
 	bulkzonesurl := "/config-dns/v2/zones/delete-requests"
 	if len(bypassSafetyChecks) > 0 {
 		bulkzonesurl += fmt.Sprintf("?bypassSafetyChecks=%t", bypassSafetyChecks[0])
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		bulkzonesurl,
 		zoneslist,
@@ -304,7 +333,7 @@ func DeleteBulkZones(zoneslist *ZoneNameListResponse, bypassSafetyChecks ...bool
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -331,3 +360,10 @@ func DeleteBulkZones(zoneslist *ZoneNameListResponse, bypassSafetyChecks ...bool
 
 	return bulkresponse, nil
 }
+
+// Bulk Delete Zones
+//
+// See: Client.DeleteBulkZones()
+func DeleteBulkZones(zoneslist *ZoneNameListResponse, bypassSafetyChecks ...bool) (*BulkZonesResponse, error) {
+	return defaultClient.DeleteBulkZones(zoneslist, bypassSafetyChecks...)
+}
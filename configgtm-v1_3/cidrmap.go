@@ -37,10 +37,9 @@ func NewCidrMap(name string) *CidrMap {
 }
 
 // ListCidrMap retreieves all CidrMaps
-func ListCidrMaps(domainName string) ([]*CidrMap, error) {
+func (c *Client) ListCidrMaps(domainName string) ([]*CidrMap, error) {
 	cidrs := &CidrMapList{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps", domainName),
 		nil,
@@ -53,7 +52,7 @@ func ListCidrMaps(domainName string) ([]*CidrMap, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -74,11 +73,17 @@ func ListCidrMaps(domainName string) ([]*CidrMap, error) {
 
 }
 
+// ListCidrMap retreieves all CidrMaps
+//
+// See: Client.ListCidrMaps()
+func ListCidrMaps(domainName string) ([]*CidrMap, error) {
+	return defaultClient.ListCidrMaps(domainName)
+}
+
 // GetCidrMap retrieves a CidrMap with the given name.
-func GetCidrMap(name, domainName string) (*CidrMap, error) {
+func (c *Client) GetCidrMap(name, domainName string) (*CidrMap, error) {
 	cidr := NewCidrMap(name)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, name),
 		nil,
@@ -91,7 +96,7 @@ func GetCidrMap(name, domainName string) (*CidrMap, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +117,13 @@ func GetCidrMap(name, domainName string) (*CidrMap, error) {
 	}
 }
 
+// GetCidrMap retrieves a CidrMap with the given name.
+//
+// See: Client.GetCidrMap()
+func GetCidrMap(name, domainName string) (*CidrMap, error) {
+	return defaultClient.GetCidrMap(name, domainName)
+}
+
 // Instantiate new Assignment struct
 func (cidr *CidrMap) NewAssignment(dcid int, nickname string) *CidrAssignment {
 	cidrAssign := &CidrAssignment{}
@@ -127,20 +139,34 @@ func (cidr *CidrMap) NewDefaultDatacenter(dcid int) *DatacenterBase {
 }
 
 // Create CidrMap in provided domain
+//
+// See: Client.CreateCidrMap()
 func (cidr *CidrMap) Create(domainName string) (*CidrMapResponse, error) {
+	return defaultClient.CreateCidrMap(cidr, domainName)
+}
+
+// Create CidrMap in provided domain
+func (c *Client) CreateCidrMap(cidr *CidrMap, domainName string) (*CidrMapResponse, error) {
 
 	// Use common code. Any specific validation needed?
 
-	return cidr.save(domainName)
+	return c.saveCidrMap(cidr, domainName)
 
 }
 
 // Update CidrMap in given domain
+//
+// See: Client.UpdateCidrMap()
 func (cidr *CidrMap) Update(domainName string) (*ResponseStatus, error) {
+	return defaultClient.UpdateCidrMap(cidr, domainName)
+}
+
+// Update CidrMap in given domain
+func (c *Client) UpdateCidrMap(cidr *CidrMap, domainName string) (*ResponseStatus, error) {
 
 	// common code
 
-	stat, err := cidr.save(domainName)
+	stat, err := c.saveCidrMap(cidr, domainName)
 	if err != nil {
 		return nil, err
 	}
@@ -149,10 +175,9 @@ func (cidr *CidrMap) Update(domainName string) (*ResponseStatus, error) {
 }
 
 // Save CidrMap in given domain. Common path for Create and Update.
-func (cidr *CidrMap) save(domainName string) (*CidrMapResponse, error) {
+func (c *Client) saveCidrMap(cidr *CidrMap, domainName string) (*CidrMapResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, cidr.Name),
 		cidr,
@@ -165,7 +190,7 @@ func (cidr *CidrMap) save(domainName string) (*CidrMapResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -196,10 +221,16 @@ func (cidr *CidrMap) save(domainName string) (*CidrMapResponse, error) {
 }
 
 // Delete CidrMap method
+//
+// See: Client.DeleteCidrMap()
 func (cidr *CidrMap) Delete(domainName string) (*ResponseStatus, error) {
+	return defaultClient.DeleteCidrMap(cidr, domainName)
+}
+
+// Delete CidrMap method
+func (c *Client) DeleteCidrMap(cidr *CidrMap, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, cidr.Name),
 		nil,
@@ -212,7 +243,7 @@ func (cidr *CidrMap) Delete(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -52,10 +52,9 @@ func NewDatacenter() *Datacenter {
 }
 
 // ListDatacenters retreieves all Datacenters
-func ListDatacenters(domainName string) ([]*Datacenter, error) {
+func (c *Client) ListDatacenters(domainName string) ([]*Datacenter, error) {
 	dcs := &DatacenterList{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters", domainName),
 		nil,
@@ -68,7 +67,7 @@ func ListDatacenters(domainName string) ([]*Datacenter, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -89,12 +88,18 @@ func ListDatacenters(domainName string) ([]*Datacenter, error) {
 	}
 }
 
+// ListDatacenters retreieves all Datacenters
+//
+// See: Client.ListDatacenters()
+func ListDatacenters(domainName string) ([]*Datacenter, error) {
+	return defaultClient.ListDatacenters(domainName)
+}
+
 // GetDatacenter retrieves a Datacenter with the given name. NOTE: Id arg is int!
-func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
+func (c *Client) GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
 
 	dc := NewDatacenter()
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dcID)),
 		nil,
@@ -107,7 +112,7 @@ func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -128,11 +133,24 @@ func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
 	}
 }
 
+// GetDatacenter retrieves a Datacenter with the given name. NOTE: Id arg is int!
+//
+// See: Client.GetDatacenter()
+func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
+	return defaultClient.GetDatacenter(dcID, domainName)
+}
+
 // Create the datacenter identified by the receiver argument in the specified domain.
+//
+// See: Client.CreateDatacenter()
 func (dc *Datacenter) Create(domainName string) (*DatacenterResponse, error) {
+	return defaultClient.CreateDatacenter(dc, domainName)
+}
+
+// Create the datacenter identified by the receiver argument in the specified domain.
+func (c *Client) CreateDatacenter(dc *Datacenter, domainName string) (*DatacenterResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters", domainName),
 		dc,
@@ -145,7 +163,7 @@ func (dc *Datacenter) Create(domainName string) (*DatacenterResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network
 	if err != nil {
@@ -177,10 +195,16 @@ func (dc *Datacenter) Create(domainName string) (*DatacenterResponse, error) {
 }
 
 // Update the datacenter identified in the receiver argument in the provided domain.
+//
+// See: Client.UpdateDatacenter()
 func (dc *Datacenter) Update(domainName string) (*ResponseStatus, error) {
+	return defaultClient.UpdateDatacenter(dc, domainName)
+}
 
-	req, err := client.NewJSONRequest(
-		Config,
+// Update the datacenter identified in the receiver argument in the provided domain.
+func (c *Client) UpdateDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error) {
+
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dc.DatacenterId)),
 		dc,
@@ -193,7 +217,7 @@ func (dc *Datacenter) Update(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -224,10 +248,16 @@ func (dc *Datacenter) Update(domainName string) (*ResponseStatus, error) {
 }
 
 // Delete the datacenter identified by the receiver argument from the domain specified.
+//
+// See: Client.DeleteDatacenter()
 func (dc *Datacenter) Delete(domainName string) (*ResponseStatus, error) {
+	return defaultClient.DeleteDatacenter(dc, domainName)
+}
+
+// Delete the datacenter identified by the receiver argument from the domain specified.
+func (c *Client) DeleteDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dc.DatacenterId)),
 		nil,
@@ -240,7 +270,7 @@ func (dc *Datacenter) Delete(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,8 @@ package configgtm
 
 import (
 	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 )
 
 type ConfigGTMError interface {
@@ -106,3 +108,22 @@ func (e CommonError) Error() string {
 
 	return "<nil>"
 }
+
+// Is tells whether the error is of the category target, e.g. client.ErrNotFound:
+// that of the client.APIError or network error it wraps, if any.
+func (e CommonError) Is(target error) bool {
+	if e.err != nil {
+		return target == client.Category(e.err)
+	}
+	return target == client.ErrNotFound && e.NotFound()
+}
+
+// Unwrap returns the underlying error, e.g. a client.APIError
+func (e CommonError) Unwrap() error {
+	return e.err
+}
+
+// Retryable tells whether the failed call may succeed if made again, later
+func (e CommonError) Retryable() bool {
+	return client.IsRetryable(e.err)
+}
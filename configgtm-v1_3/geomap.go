@@ -37,10 +37,9 @@ func NewGeoMap(name string) *GeoMap {
 }
 
 // ListGeoMap retreieves all GeoMaps
-func ListGeoMaps(domainName string) ([]*GeoMap, error) {
+func (c *Client) ListGeoMaps(domainName string) ([]*GeoMap, error) {
 	geos := &GeoMapList{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps", domainName),
 		nil,
@@ -53,7 +52,7 @@ func ListGeoMaps(domainName string) ([]*GeoMap, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -74,12 +73,18 @@ func ListGeoMaps(domainName string) ([]*GeoMap, error) {
 
 }
 
+// ListGeoMap retreieves all GeoMaps
+//
+// See: Client.ListGeoMaps()
+func ListGeoMaps(domainName string) ([]*GeoMap, error) {
+	return defaultClient.ListGeoMaps(domainName)
+}
+
 // GetGeoMap retrieves a GeoMap with the given name.
-func GetGeoMap(name, domainName string) (*GeoMap, error) {
+func (c *Client) GetGeoMap(name, domainName string) (*GeoMap, error) {
 	geo := NewGeoMap(name)
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, name),
 		nil,
@@ -92,7 +97,7 @@ func GetGeoMap(name, domainName string) (*GeoMap, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +118,13 @@ func GetGeoMap(name, domainName string) (*GeoMap, error) {
 	}
 }
 
+// GetGeoMap retrieves a GeoMap with the given name.
+//
+// See: Client.GetGeoMap()
+func GetGeoMap(name, domainName string) (*GeoMap, error) {
+	return defaultClient.GetGeoMap(name, domainName)
+}
+
 // Instantiate new Assignment struct
 func (geo *GeoMap) NewAssignment(dcID int, nickname string) *GeoAssignment {
 	geoAssign := &GeoAssignment{}
@@ -129,20 +141,34 @@ func (geo *GeoMap) NewDefaultDatacenter(dcID int) *DatacenterBase {
 }
 
 // Create GeoMap in provided domain
+//
+// See: Client.CreateGeoMap()
 func (geo *GeoMap) Create(domainName string) (*GeoMapResponse, error) {
+	return defaultClient.CreateGeoMap(geo, domainName)
+}
+
+// Create GeoMap in provided domain
+func (c *Client) CreateGeoMap(geo *GeoMap, domainName string) (*GeoMapResponse, error) {
 
 	// Use common code. Any specific validation needed?
 
-	return geo.save(domainName)
+	return c.saveGeoMap(geo, domainName)
 
 }
 
 // Update GeoMap in given domain
+//
+// See: Client.UpdateGeoMap()
 func (geo *GeoMap) Update(domainName string) (*ResponseStatus, error) {
+	return defaultClient.UpdateGeoMap(geo, domainName)
+}
+
+// Update GeoMap in given domain
+func (c *Client) UpdateGeoMap(geo *GeoMap, domainName string) (*ResponseStatus, error) {
 
 	// common code
 
-	stat, err := geo.save(domainName)
+	stat, err := c.saveGeoMap(geo, domainName)
 	if err != nil {
 		return nil, err
 	}
@@ -151,10 +177,9 @@ func (geo *GeoMap) Update(domainName string) (*ResponseStatus, error) {
 }
 
 // Save GeoMap in given domain. Common path for Create and Update.
-func (geo *GeoMap) save(domainName string) (*GeoMapResponse, error) {
+func (c *Client) saveGeoMap(geo *GeoMap, domainName string) (*GeoMapResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, geo.Name),
 		geo,
@@ -167,7 +192,7 @@ func (geo *GeoMap) save(domainName string) (*GeoMapResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -198,10 +223,16 @@ func (geo *GeoMap) save(domainName string) (*GeoMapResponse, error) {
 }
 
 // Delete GeoMap method
+//
+// See: Client.DeleteGeoMap()
 func (geo *GeoMap) Delete(domainName string) (*ResponseStatus, error) {
+	return defaultClient.DeleteGeoMap(geo, domainName)
+}
+
+// Delete GeoMap method
+func (c *Client) DeleteGeoMap(geo *GeoMap, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, geo.Name),
 		nil,
@@ -214,7 +245,7 @@ func (geo *GeoMap) Delete(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -32,10 +32,9 @@ func NewAsMap(name string) *AsMap {
 }
 
 // GetAsMap retrieves a asMap with the given name.
-func GetAsMap(name, domainName string) (*AsMap, error) {
+func (c *Client) GetAsMap(name, domainName string) (*AsMap, error) {
 	as := NewAsMap(name)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/as-maps/%s", domainName, name),
 		nil,
@@ -48,7 +47,7 @@ func GetAsMap(name, domainName string) (*AsMap, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +68,13 @@ func GetAsMap(name, domainName string) (*AsMap, error) {
 	}
 }
 
+// GetAsMap retrieves a asMap with the given name.
+//
+// See: Client.GetAsMap()
+func GetAsMap(name, domainName string) (*AsMap, error) {
+	return defaultClient.GetAsMap(name, domainName)
+}
+
 // Instantiate new Assignment struct
 func (as *AsMap) NewAssignment(dcID int, nickname string) *AsAssignment {
 	asAssign := &AsAssignment{}
@@ -85,20 +91,34 @@ func (as *AsMap) NewDefaultDatacenter(dcID int) *DatacenterBase {
 }
 
 // Create asMap in provided domain
+//
+// See: Client.CreateAsMap()
 func (as *AsMap) Create(domainName string) (*AsMapResponse, error) {
+	return defaultClient.CreateAsMap(as, domainName)
+}
+
+// Create asMap in provided domain
+func (c *Client) CreateAsMap(as *AsMap, domainName string) (*AsMapResponse, error) {
 
 	// Use common code. Any specific validation needed?
 
-	return as.save(domainName)
+	return c.saveAsMap(as, domainName)
 
 }
 
 // Update AsMap in given domain
+//
+// See: Client.UpdateAsMap()
 func (as *AsMap) Update(domainName string) (*ResponseStatus, error) {
+	return defaultClient.UpdateAsMap(as, domainName)
+}
+
+// Update AsMap in given domain
+func (c *Client) UpdateAsMap(as *AsMap, domainName string) (*ResponseStatus, error) {
 
 	// common code
 
-	stat, err := as.save(domainName)
+	stat, err := c.saveAsMap(as, domainName)
 	if err != nil {
 		return nil, err
 	}
@@ -107,10 +127,9 @@ func (as *AsMap) Update(domainName string) (*ResponseStatus, error) {
 }
 
 // Save AsMap in given domain. Common path for Create and Update.
-func (as *AsMap) save(domainName string) (*AsMapResponse, error) {
+func (c *Client) saveAsMap(as *AsMap, domainName string) (*AsMapResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/as-maps/%s", domainName, as.Name),
 		as,
@@ -123,7 +142,7 @@ func (as *AsMap) save(domainName string) (*AsMapResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -154,10 +173,16 @@ func (as *AsMap) save(domainName string) (*AsMapResponse, error) {
 }
 
 // Delete AsMap method
+//
+// See: Client.DeleteAsMap()
 func (as *AsMap) Delete(domainName string) (*ResponseStatus, error) {
+	return defaultClient.DeleteAsMap(as, domainName)
+}
+
+// Delete AsMap method
+func (c *Client) DeleteAsMap(as *AsMap, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/as-maps/%s", domainName, as.Name),
 		nil,
@@ -170,7 +195,7 @@ func (as *AsMap) Delete(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -54,10 +54,9 @@ func NewDatacenter() *Datacenter {
 }
 
 // ListDatacenters retreieves all Datacenters
-func ListDatacenters(domainName string) ([]*Datacenter, error) {
+func (c *Client) ListDatacenters(domainName string) ([]*Datacenter, error) {
 	dcs := &DatacenterList{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters", domainName),
 		nil,
@@ -70,7 +69,7 @@ func ListDatacenters(domainName string) ([]*Datacenter, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -91,12 +90,18 @@ func ListDatacenters(domainName string) ([]*Datacenter, error) {
 	}
 }
 
+// ListDatacenters retreieves all Datacenters
+//
+// See: Client.ListDatacenters()
+func ListDatacenters(domainName string) ([]*Datacenter, error) {
+	return defaultClient.ListDatacenters(domainName)
+}
+
 // GetDatacenter retrieves a Datacenter with the given name. NOTE: Id arg is int!
-func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
+func (c *Client) GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
 
 	dc := NewDatacenter()
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dcID)),
 		nil,
@@ -109,7 +114,7 @@ func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -130,11 +135,24 @@ func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
 	}
 }
 
+// GetDatacenter retrieves a Datacenter with the given name. NOTE: Id arg is int!
+//
+// See: Client.GetDatacenter()
+func GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
+	return defaultClient.GetDatacenter(dcID, domainName)
+}
+
 // Create the datacenter identified by the receiver argument in the specified domain.
+//
+// See: Client.CreateDatacenter()
 func (dc *Datacenter) Create(domainName string) (*DatacenterResponse, error) {
+	return defaultClient.CreateDatacenter(dc, domainName)
+}
+
+// Create the datacenter identified by the receiver argument in the specified domain.
+func (c *Client) CreateDatacenter(dc *Datacenter, domainName string) (*DatacenterResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters", domainName),
 		dc,
@@ -147,7 +165,7 @@ func (dc *Datacenter) Create(domainName string) (*DatacenterResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network
 	if err != nil {
@@ -183,34 +201,55 @@ var Ipv4DefaultDC int = 5401
 var Ipv6DefaultDC int = 5402
 
 // Create Default Datacenter for Maps
+func (c *Client) CreateMapsDefaultDatacenter(domainName string) (*Datacenter, error) {
+
+	return c.createDefaultDC(MapDefaultDC, domainName)
+
+}
+
+// Create Default Datacenter for Maps
+//
+// See: Client.CreateMapsDefaultDatacenter()
 func CreateMapsDefaultDatacenter(domainName string) (*Datacenter, error) {
+	return defaultClient.CreateMapsDefaultDatacenter(domainName)
+}
+
+// Create Default Datacenter for IPv4 Selector
+func (c *Client) CreateIPv4DefaultDatacenter(domainName string) (*Datacenter, error) {
 
-	return createDefaultDC(MapDefaultDC, domainName)
+	return c.createDefaultDC(Ipv4DefaultDC, domainName)
 
 }
 
 // Create Default Datacenter for IPv4 Selector
+//
+// See: Client.CreateIPv4DefaultDatacenter()
 func CreateIPv4DefaultDatacenter(domainName string) (*Datacenter, error) {
+	return defaultClient.CreateIPv4DefaultDatacenter(domainName)
+}
 
-	return createDefaultDC(Ipv4DefaultDC, domainName)
+// Create Default Datacenter for IPv6 Selector
+func (c *Client) CreateIPv6DefaultDatacenter(domainName string) (*Datacenter, error) {
+
+	return c.createDefaultDC(Ipv6DefaultDC, domainName)
 
 }
 
 // Create Default Datacenter for IPv6 Selector
+//
+// See: Client.CreateIPv6DefaultDatacenter()
 func CreateIPv6DefaultDatacenter(domainName string) (*Datacenter, error) {
-
-	return createDefaultDC(Ipv6DefaultDC, domainName)
-
+	return defaultClient.CreateIPv6DefaultDatacenter(domainName)
 }
 
 // Worker function to create Default Datacenter identified id in the specified domain.
-func createDefaultDC(defaultID int, domainName string) (*Datacenter, error) {
+func (c *Client) createDefaultDC(defaultID int, domainName string) (*Datacenter, error) {
 
 	if defaultID != MapDefaultDC && defaultID != Ipv4DefaultDC && defaultID != Ipv6DefaultDC {
 		return nil, errors.New("Invalid default datacenter id provided for creation")
 	}
 	// check if already exists
-	dc, err := GetDatacenter(defaultID, domainName)
+	dc, err := c.GetDatacenter(defaultID, domainName)
 	if err == nil {
 		return dc, err
 	} else {
@@ -227,8 +266,7 @@ func createDefaultDC(defaultID int, domainName string) (*Datacenter, error) {
 	case Ipv6DefaultDC:
 		defaultURL += "datacenter-for-ip-version-selector-ipv6"
 	}
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		defaultURL,
 		"",
@@ -238,7 +276,7 @@ func createDefaultDC(defaultID int, domainName string) (*Datacenter, error) {
 	}
 	setVersionHeader(req, schemaVersion)
 	printHttpRequest(req, true)
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	// Network
 	if err != nil {
 		return nil, CommonError{
@@ -266,10 +304,16 @@ func createDefaultDC(defaultID int, domainName string) (*Datacenter, error) {
 }
 
 // Update the datacenter identified in the receiver argument in the provided domain.
+//
+// See: Client.UpdateDatacenter()
 func (dc *Datacenter) Update(domainName string) (*ResponseStatus, error) {
+	return defaultClient.UpdateDatacenter(dc, domainName)
+}
+
+// Update the datacenter identified in the receiver argument in the provided domain.
+func (c *Client) UpdateDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dc.DatacenterId)),
 		dc,
@@ -282,7 +326,7 @@ func (dc *Datacenter) Update(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -313,10 +357,16 @@ func (dc *Datacenter) Update(domainName string) (*ResponseStatus, error) {
 }
 
 // Delete the datacenter identified by the receiver argument from the domain specified.
+//
+// See: Client.DeleteDatacenter()
 func (dc *Datacenter) Delete(domainName string) (*ResponseStatus, error) {
+	return defaultClient.DeleteDatacenter(dc, domainName)
+}
+
+// Delete the datacenter identified by the receiver argument from the domain specified.
+func (c *Client) DeleteDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dc.DatacenterId)),
 		nil,
@@ -329,7 +379,7 @@ func (dc *Datacenter) Delete(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
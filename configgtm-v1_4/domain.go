@@ -81,10 +81,9 @@ func NewDomain(domainName, domainType string) *Domain {
 }
 
 // GetStatus retrieves current status for the given domainname.
-func GetDomainStatus(domainName string) (*ResponseStatus, error) {
+func (c *Client) GetDomainStatus(domainName string) (*ResponseStatus, error) {
 	stat := &ResponseStatus{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/status/current", domainName),
 		nil,
@@ -97,7 +96,7 @@ func GetDomainStatus(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -118,11 +117,17 @@ func GetDomainStatus(domainName string) (*ResponseStatus, error) {
 	}
 }
 
+// GetStatus retrieves current status for the given domainname.
+//
+// See: Client.GetDomainStatus()
+func GetDomainStatus(domainName string) (*ResponseStatus, error) {
+	return defaultClient.GetDomainStatus(domainName)
+}
+
 // ListDomains retrieves all Domains.
-func ListDomains() ([]*DomainItem, error) {
+func (c *Client) ListDomains() ([]*DomainItem, error) {
 	domains := &DomainsList{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		"/config-gtm/v1/domains/",
 		nil,
@@ -135,7 +140,7 @@ func ListDomains() ([]*DomainItem, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -156,11 +161,17 @@ func ListDomains() ([]*DomainItem, error) {
 	}
 }
 
+// ListDomains retrieves all Domains.
+//
+// See: Client.ListDomains()
+func ListDomains() ([]*DomainItem, error) {
+	return defaultClient.ListDomains()
+}
+
 // GetDomain retrieves a Domain with the given domainname.
-func GetDomain(domainName string) (*Domain, error) {
+func (c *Client) GetDomain(domainName string) (*Domain, error) {
 	domain := NewDomain(domainName, "basic")
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s", domainName),
 		nil,
@@ -173,7 +184,7 @@ func GetDomain(domainName string) (*Domain, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -194,8 +205,15 @@ func GetDomain(domainName string) (*Domain, error) {
 	}
 }
 
+// GetDomain retrieves a Domain with the given domainname.
+//
+// See: Client.GetDomain()
+func GetDomain(domainName string) (*Domain, error) {
+	return defaultClient.GetDomain(domainName)
+}
+
 // Save method; Create or Update
-func (domain *Domain) save(queryArgs map[string]string, req *http.Request) (*DomainResponse, error) {
+func (c *Client) saveDomain(domain *Domain, queryArgs map[string]string, req *http.Request) (*DomainResponse, error) {
 
 	// set schema version
 	setVersionHeader(req, schemaVersion)
@@ -214,7 +232,7 @@ func (domain *Domain) save(queryArgs map[string]string, req *http.Request) (*Dom
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -247,10 +265,16 @@ func (domain *Domain) save(queryArgs map[string]string, req *http.Request) (*Dom
 }
 
 // Create is a method applied to a domain object resulting in creation.
+//
+// See: Client.CreateDomain()
 func (domain *Domain) Create(queryArgs map[string]string) (*DomainResponse, error) {
+	return defaultClient.CreateDomain(domain, queryArgs)
+}
+
+// Create is a method applied to a domain object resulting in creation.
+func (c *Client) CreateDomain(domain *Domain, queryArgs map[string]string) (*DomainResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf("/config-gtm/v1/domains/"),
 		domain,
@@ -259,16 +283,22 @@ func (domain *Domain) Create(queryArgs map[string]string) (*DomainResponse, erro
 		return nil, err
 	}
 
-	return domain.save(queryArgs, req)
+	return c.saveDomain(domain, queryArgs, req)
 
 }
 
 // Update is a method applied to a domain object resulting in an update.
+//
+// See: Client.UpdateDomain()
 func (domain *Domain) Update(queryArgs map[string]string) (*ResponseStatus, error) {
+	return defaultClient.UpdateDomain(domain, queryArgs)
+}
+
+// Update is a method applied to a domain object resulting in an update.
+func (c *Client) UpdateDomain(domain *Domain, queryArgs map[string]string) (*ResponseStatus, error) {
 
 	// Any validation to do?
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s", domain.Name),
 		domain,
@@ -277,7 +307,7 @@ func (domain *Domain) Update(queryArgs map[string]string) (*ResponseStatus, erro
 		return nil, err
 	}
 
-	stat, err := domain.save(queryArgs, req)
+	stat, err := c.saveDomain(domain, queryArgs, req)
 	if err != nil {
 		return nil, err
 	}
@@ -285,10 +315,16 @@ func (domain *Domain) Update(queryArgs map[string]string) (*ResponseStatus, erro
 }
 
 // Delete is a method applied to a domain object resulting in removal.
+//
+// See: Client.DeleteDomain()
 func (domain *Domain) Delete() (*ResponseStatus, error) {
+	return defaultClient.DeleteDomain(domain)
+}
+
+// Delete is a method applied to a domain object resulting in removal.
+func (c *Client) DeleteDomain(domain *Domain) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s", domain.Name),
 		nil,
@@ -301,7 +337,7 @@ func (domain *Domain) Delete() (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -355,15 +391,21 @@ type NullFieldMapStruct struct {
 type ObjectMap map[string]interface{}
 
 // Retrieve map of null fields
+//
+// See: Client.NullFieldMap()
 func (domain *Domain) NullFieldMap() (*NullFieldMapStruct, error) {
+	return defaultClient.NullFieldMap(domain)
+}
+
+// Retrieve map of null fields
+func (c *Client) NullFieldMap(domain *Domain) (*NullFieldMapStruct, error) {
 
 	var nullFieldMap = &NullFieldMapStruct{}
 	var domFields = NullPerObjectAttributeStruct{}
 	domainMap := make(map[string]string)
 	var objMap = ObjectMap{}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s", domain.Name),
 		nil,
@@ -373,7 +415,7 @@ func (domain *Domain) NullFieldMap() (*NullFieldMapStruct, error) {
 	}
 	setVersionHeader(req, schemaVersion)
 	printHttpRequest(req, true)
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
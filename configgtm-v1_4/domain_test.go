@@ -1,8 +1,11 @@
 package configgtm
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/jsonhooks-v1"
 
 	"github.com/stretchr/testify/assert"
@@ -461,6 +464,40 @@ func TestGetBadDomain(t *testing.T) {
 
 }
 
+func TestCommonError_Categories(t *testing.T) {
+
+	defer gock.Off()
+
+	mock := gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/config-gtm/v1/domains/" + gtmTestDomain)
+	mock.
+		Get("/config-gtm/v1/domains/"+gtmTestDomain).
+		HeaderPresent("Authorization").
+		Reply(404).
+		SetHeader("Content-Type", "application/vnd.config-gtm.v1.4+json;charset=UTF-8").
+		BodyString(`{}`)
+
+	mock = gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/config-gtm/v1/domains")
+	mock.
+		Post("/config-gtm/v1/domains/").
+		HeaderPresent("Authorization").
+		Reply(400).
+		SetHeader("Content-Type", "application/problem+json").
+		BodyString(`{"type": "https://problems.luna.akamaiapis.net/config-gtm/v1/invalid", "title": "Bad Request", "status": 400, "detail": "contractId is required"}`)
+
+	Init(config)
+
+	_, err := GetDomain(gtmTestDomain)
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+
+	_, err = NewDomain(gtmTestDomain, "basic").Create(map[string]string{})
+	assert.True(t, errors.Is(err, client.ErrValidation))
+	var apiErr client.APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, "contractId is required", apiErr.Detail)
+	}
+	assert.False(t, client.IsRetryable(err))
+}
+
 // Test Create domain. Name is hardcoded so this will effectively be an update. What happens to existing?
 func TestCreateDomain(t *testing.T) {
 
@@ -0,0 +1,205 @@
+package configgtm
+
+import "sync"
+
+// Fake is a configurable implementation of DomainService, PropertyService and DatacenterService,
+// for unit tests of code using them without any HTTP. Each method records
+// its call, then calls the function of its name with a Func suffix, e.g.
+// GetDomainFunc, if set, or else returns zero values.
+//
+//	fake := &configgtm.Fake{
+//		GetDomainFunc: func(domainName string) (*configgtm.Domain, error) {
+//			return configgtm.NewDomain(domainName, "basic"), nil
+//		},
+//	}
+type Fake struct {
+	ListDomainsFunc      func() ([]*DomainItem, error)
+	GetDomainFunc        func(domainName string) (*Domain, error)
+	CreateDomainFunc     func(domain *Domain, queryArgs map[string]string) (*DomainResponse, error)
+	UpdateDomainFunc     func(domain *Domain, queryArgs map[string]string) (*ResponseStatus, error)
+	DeleteDomainFunc     func(domain *Domain) (*ResponseStatus, error)
+	GetDomainStatusFunc  func(domainName string) (*ResponseStatus, error)
+	ListPropertiesFunc   func(domainName string) ([]*Property, error)
+	GetPropertyFunc      func(name, domainName string) (*Property, error)
+	CreatePropertyFunc   func(property *Property, domainName string) (*PropertyResponse, error)
+	UpdatePropertyFunc   func(property *Property, domainName string) (*ResponseStatus, error)
+	DeletePropertyFunc   func(property *Property, domainName string) (*ResponseStatus, error)
+	ListDatacentersFunc  func(domainName string) ([]*Datacenter, error)
+	GetDatacenterFunc    func(dcID int, domainName string) (*Datacenter, error)
+	CreateDatacenterFunc func(dc *Datacenter, domainName string) (*DatacenterResponse, error)
+	UpdateDatacenterFunc func(dc *Datacenter, domainName string) (*ResponseStatus, error)
+	DeleteDatacenterFunc func(dc *Datacenter, domainName string) (*ResponseStatus, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+// FakeCall is a call of a method of a Fake, with its arguments
+type FakeCall struct {
+	Method string
+	Args   []interface{}
+}
+
+var (
+	_ DomainService     = (*Fake)(nil)
+	_ PropertyService   = (*Fake)(nil)
+	_ DatacenterService = (*Fake)(nil)
+)
+
+// Calls returns the calls made so far, in order
+func (f *Fake) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// record records a call of method with args
+func (f *Fake) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args})
+}
+
+// ListDomains calls ListDomainsFunc
+func (f *Fake) ListDomains() ([]*DomainItem, error) {
+	f.record("ListDomains")
+	if f.ListDomainsFunc == nil {
+		return nil, nil
+	}
+	return f.ListDomainsFunc()
+}
+
+// GetDomain calls GetDomainFunc
+func (f *Fake) GetDomain(domainName string) (*Domain, error) {
+	f.record("GetDomain", domainName)
+	if f.GetDomainFunc == nil {
+		return nil, nil
+	}
+	return f.GetDomainFunc(domainName)
+}
+
+// CreateDomain calls CreateDomainFunc
+func (f *Fake) CreateDomain(domain *Domain, queryArgs map[string]string) (*DomainResponse, error) {
+	f.record("CreateDomain", domain, queryArgs)
+	if f.CreateDomainFunc == nil {
+		return nil, nil
+	}
+	return f.CreateDomainFunc(domain, queryArgs)
+}
+
+// UpdateDomain calls UpdateDomainFunc
+func (f *Fake) UpdateDomain(domain *Domain, queryArgs map[string]string) (*ResponseStatus, error) {
+	f.record("UpdateDomain", domain, queryArgs)
+	if f.UpdateDomainFunc == nil {
+		return nil, nil
+	}
+	return f.UpdateDomainFunc(domain, queryArgs)
+}
+
+// DeleteDomain calls DeleteDomainFunc
+func (f *Fake) DeleteDomain(domain *Domain) (*ResponseStatus, error) {
+	f.record("DeleteDomain", domain)
+	if f.DeleteDomainFunc == nil {
+		return nil, nil
+	}
+	return f.DeleteDomainFunc(domain)
+}
+
+// GetDomainStatus calls GetDomainStatusFunc
+func (f *Fake) GetDomainStatus(domainName string) (*ResponseStatus, error) {
+	f.record("GetDomainStatus", domainName)
+	if f.GetDomainStatusFunc == nil {
+		return nil, nil
+	}
+	return f.GetDomainStatusFunc(domainName)
+}
+
+// ListProperties calls ListPropertiesFunc
+func (f *Fake) ListProperties(domainName string) ([]*Property, error) {
+	f.record("ListProperties", domainName)
+	if f.ListPropertiesFunc == nil {
+		return nil, nil
+	}
+	return f.ListPropertiesFunc(domainName)
+}
+
+// GetProperty calls GetPropertyFunc
+func (f *Fake) GetProperty(name, domainName string) (*Property, error) {
+	f.record("GetProperty", name, domainName)
+	if f.GetPropertyFunc == nil {
+		return nil, nil
+	}
+	return f.GetPropertyFunc(name, domainName)
+}
+
+// CreateProperty calls CreatePropertyFunc
+func (f *Fake) CreateProperty(property *Property, domainName string) (*PropertyResponse, error) {
+	f.record("CreateProperty", property, domainName)
+	if f.CreatePropertyFunc == nil {
+		return nil, nil
+	}
+	return f.CreatePropertyFunc(property, domainName)
+}
+
+// UpdateProperty calls UpdatePropertyFunc
+func (f *Fake) UpdateProperty(property *Property, domainName string) (*ResponseStatus, error) {
+	f.record("UpdateProperty", property, domainName)
+	if f.UpdatePropertyFunc == nil {
+		return nil, nil
+	}
+	return f.UpdatePropertyFunc(property, domainName)
+}
+
+// DeleteProperty calls DeletePropertyFunc
+func (f *Fake) DeleteProperty(property *Property, domainName string) (*ResponseStatus, error) {
+	f.record("DeleteProperty", property, domainName)
+	if f.DeletePropertyFunc == nil {
+		return nil, nil
+	}
+	return f.DeletePropertyFunc(property, domainName)
+}
+
+// ListDatacenters calls ListDatacentersFunc
+func (f *Fake) ListDatacenters(domainName string) ([]*Datacenter, error) {
+	f.record("ListDatacenters", domainName)
+	if f.ListDatacentersFunc == nil {
+		return nil, nil
+	}
+	return f.ListDatacentersFunc(domainName)
+}
+
+// GetDatacenter calls GetDatacenterFunc
+func (f *Fake) GetDatacenter(dcID int, domainName string) (*Datacenter, error) {
+	f.record("GetDatacenter", dcID, domainName)
+	if f.GetDatacenterFunc == nil {
+		return nil, nil
+	}
+	return f.GetDatacenterFunc(dcID, domainName)
+}
+
+// CreateDatacenter calls CreateDatacenterFunc
+func (f *Fake) CreateDatacenter(dc *Datacenter, domainName string) (*DatacenterResponse, error) {
+	f.record("CreateDatacenter", dc, domainName)
+	if f.CreateDatacenterFunc == nil {
+		return nil, nil
+	}
+	return f.CreateDatacenterFunc(dc, domainName)
+}
+
+// UpdateDatacenter calls UpdateDatacenterFunc
+func (f *Fake) UpdateDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error) {
+	f.record("UpdateDatacenter", dc, domainName)
+	if f.UpdateDatacenterFunc == nil {
+		return nil, nil
+	}
+	return f.UpdateDatacenterFunc(dc, domainName)
+}
+
+// DeleteDatacenter calls DeleteDatacenterFunc
+func (f *Fake) DeleteDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error) {
+	f.record("DeleteDatacenter", dc, domainName)
+	if f.DeleteDatacenterFunc == nil {
+		return nil, nil
+	}
+	return f.DeleteDatacenterFunc(dc, domainName)
+}
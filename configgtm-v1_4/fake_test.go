@@ -0,0 +1,42 @@
+package configgtm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake_Funcs(t *testing.T) {
+	boom := errors.New("boom")
+	fake := &Fake{
+		GetDomainFunc: func(domainName string) (*Domain, error) {
+			return NewDomain(domainName, "basic"), nil
+		},
+		DeletePropertyFunc: func(property *Property, domainName string) (*ResponseStatus, error) {
+			return nil, boom
+		},
+	}
+	var domains DomainService = fake
+	var properties PropertyService = fake
+	var datacenters DatacenterService = fake
+
+	domain, err := domains.GetDomain(gtmTestDomain)
+	require.NoError(t, err)
+	assert.Equal(t, gtmTestDomain, domain.Name)
+
+	property := NewProperty("www")
+	_, err = properties.DeleteProperty(property, gtmTestDomain)
+	assert.Equal(t, boom, err)
+
+	dcs, err := datacenters.ListDatacenters(gtmTestDomain)
+	assert.NoError(t, err)
+	assert.Nil(t, dcs)
+
+	assert.Equal(t, []FakeCall{
+		{Method: "GetDomain", Args: []interface{}{gtmTestDomain}},
+		{Method: "DeleteProperty", Args: []interface{}{property, gtmTestDomain}},
+		{Method: "ListDatacenters", Args: []interface{}{gtmTestDomain}},
+	}, fake.Calls())
+}
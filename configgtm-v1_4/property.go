@@ -139,10 +139,9 @@ func NewProperty(name string) *Property {
 }
 
 // ListProperties retreieves all Properties for the provided domainName.
-func ListProperties(domainName string) ([]*Property, error) {
+func (c *Client) ListProperties(domainName string) ([]*Property, error) {
 	properties := &PropertyList{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/properties", domainName),
 		nil,
@@ -155,7 +154,7 @@ func ListProperties(domainName string) ([]*Property, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -176,11 +175,17 @@ func ListProperties(domainName string) ([]*Property, error) {
 	}
 }
 
+// ListProperties retreieves all Properties for the provided domainName.
+//
+// See: Client.ListProperties()
+func ListProperties(domainName string) ([]*Property, error) {
+	return defaultClient.ListProperties(domainName)
+}
+
 // GetProperty retrieves a Property with the given name.
-func GetProperty(name, domainName string) (*Property, error) {
+func (c *Client) GetProperty(name, domainName string) (*Property, error) {
 	property := NewProperty(name)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", domainName, name),
 		nil,
@@ -193,7 +198,7 @@ func GetProperty(name, domainName string) (*Property, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -214,18 +219,39 @@ func GetProperty(name, domainName string) (*Property, error) {
 	}
 }
 
+// GetProperty retrieves a Property with the given name.
+//
+// See: Client.GetProperty()
+func GetProperty(name, domainName string) (*Property, error) {
+	return defaultClient.GetProperty(name, domainName)
+}
+
 // Create the property in the receiver argument in the specified domain.
+//
+// See: Client.CreateProperty()
 func (property *Property) Create(domainName string) (*PropertyResponse, error) {
+	return defaultClient.CreateProperty(property, domainName)
+}
+
+// Create the property in the receiver argument in the specified domain.
+func (c *Client) CreateProperty(property *Property, domainName string) (*PropertyResponse, error) {
 
 	// Need do any validation?
-	return property.save(domainName)
+	return c.saveProperty(property, domainName)
 }
 
 // Update the property in the receiver argument in the specified domain.
+//
+// See: Client.UpdateProperty()
 func (property *Property) Update(domainName string) (*ResponseStatus, error) {
+	return defaultClient.UpdateProperty(property, domainName)
+}
+
+// Update the property in the receiver argument in the specified domain.
+func (c *Client) UpdateProperty(property *Property, domainName string) (*ResponseStatus, error) {
 
 	// Need do any validation?
-	stat, err := property.save(domainName)
+	stat, err := c.saveProperty(property, domainName)
 	if err != nil {
 		return nil, err
 	}
@@ -234,10 +260,9 @@ func (property *Property) Update(domainName string) (*ResponseStatus, error) {
 }
 
 // Save Property updates method
-func (property *Property) save(domainName string) (*PropertyResponse, error) {
+func (c *Client) saveProperty(property *Property, domainName string) (*PropertyResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", domainName, property.Name),
 		property,
@@ -250,7 +275,7 @@ func (property *Property) save(domainName string) (*PropertyResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -282,10 +307,16 @@ func (property *Property) save(domainName string) (*PropertyResponse, error) {
 }
 
 // Delete the property identified by the receiver argument from the domain provided.
+//
+// See: Client.DeleteProperty()
 func (property *Property) Delete(domainName string) (*ResponseStatus, error) {
+	return defaultClient.DeleteProperty(property, domainName)
+}
+
+// Delete the property identified by the receiver argument from the domain provided.
+func (c *Client) DeleteProperty(property *Property, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", domainName, property.Name),
 		nil,
@@ -298,7 +329,7 @@ func (property *Property) Delete(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -54,10 +54,9 @@ func NewResource(name string) *Resource {
 }
 
 // ListResources retreieves all Resources in the specified domain.
-func ListResources(domainName string) ([]*Resource, error) {
+func (c *Client) ListResources(domainName string) ([]*Resource, error) {
 	rsrcs := &ResourceList{}
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/resources", domainName),
 		nil,
@@ -70,7 +69,7 @@ func ListResources(domainName string) ([]*Resource, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -91,11 +90,17 @@ func ListResources(domainName string) ([]*Resource, error) {
 
 }
 
+// ListResources retreieves all Resources in the specified domain.
+//
+// See: Client.ListResources()
+func ListResources(domainName string) ([]*Resource, error) {
+	return defaultClient.ListResources(domainName)
+}
+
 // GetResource retrieves a Resource with the given name in the specified domain.
-func GetResource(name, domainName string) (*Resource, error) {
+func (c *Client) GetResource(name, domainName string) (*Resource, error) {
 	rsc := NewResource(name)
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/resources/%s", domainName, name),
 		nil,
@@ -108,7 +113,7 @@ func GetResource(name, domainName string) (*Resource, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -129,21 +134,42 @@ func GetResource(name, domainName string) (*Resource, error) {
 	}
 }
 
+// GetResource retrieves a Resource with the given name in the specified domain.
+//
+// See: Client.GetResource()
+func GetResource(name, domainName string) (*Resource, error) {
+	return defaultClient.GetResource(name, domainName)
+}
+
 // Create the resource identified by the receiver argument in the specified domain.
+//
+// See: Client.CreateResource()
 func (rsrc *Resource) Create(domainName string) (*ResourceResponse, error) {
+	return defaultClient.CreateResource(rsrc, domainName)
+}
+
+// Create the resource identified by the receiver argument in the specified domain.
+func (c *Client) CreateResource(rsrc *Resource, domainName string) (*ResourceResponse, error) {
 
 	// Use common code. Any specific validation needed?
 
-	return rsrc.save(domainName)
+	return c.saveResource(rsrc, domainName)
 
 }
 
 // Update the resourceidentified in the receiver argument in the specified domain.
+//
+// See: Client.UpdateResource()
 func (rsrc *Resource) Update(domainName string) (*ResponseStatus, error) {
+	return defaultClient.UpdateResource(rsrc, domainName)
+}
+
+// Update the resourceidentified in the receiver argument in the specified domain.
+func (c *Client) UpdateResource(rsrc *Resource, domainName string) (*ResponseStatus, error) {
 
 	// common code
 
-	stat, err := rsrc.save(domainName)
+	stat, err := c.saveResource(rsrc, domainName)
 	if err != nil {
 		return nil, err
 	}
@@ -152,10 +178,9 @@ func (rsrc *Resource) Update(domainName string) (*ResponseStatus, error) {
 }
 
 // Save Resource in given domain. Common path for Create and Update.
-func (rsrc *Resource) save(domainName string) (*ResourceResponse, error) {
+func (c *Client) saveResource(rsrc *Resource, domainName string) (*ResourceResponse, error) {
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := c.session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/resources/%s", domainName, rsrc.Name),
 		rsrc,
@@ -169,7 +194,7 @@ func (rsrc *Resource) save(domainName string) (*ResourceResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	// Network error
 	if err != nil {
@@ -201,10 +226,16 @@ func (rsrc *Resource) save(domainName string) (*ResourceResponse, error) {
 }
 
 // Delete the resource identified in the receiver argument from the specified domain.
+//
+// See: Client.DeleteResource()
 func (rsrc *Resource) Delete(domainName string) (*ResponseStatus, error) {
+	return defaultClient.DeleteResource(rsrc, domainName)
+}
+
+// Delete the resource identified in the receiver argument from the specified domain.
+func (c *Client) DeleteResource(rsrc *Resource, domainName string) (*ResponseStatus, error) {
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"DELETE",
 		fmt.Sprintf("/config-gtm/v1/domains/%s/resources/%s", domainName, rsrc.Name),
 		nil,
@@ -217,7 +248,7 @@ func (rsrc *Resource) Delete(domainName string) (*ResponseStatus, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
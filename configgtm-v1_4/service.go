@@ -1,6 +1,8 @@
 package configgtm
 
 import (
+	"context"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 	"net/http"
 )
@@ -9,8 +11,26 @@ var (
 	// Config contains the Akamai OPEN Edgegrid API credentials
 	// for automatic signing of requests
 	Config edgegrid.Config
+
+	// defaultClient is used by the package-level functions and methods, with Config
+	defaultClient = New(client.NewLiveSession(&Config))
 )
 
+// Client is a GTM client bound to a client.Session
+type Client struct {
+	session *client.Session
+}
+
+// New creates a new GTM Client using session
+func New(session *client.Session) *Client {
+	return &Client{session: session}
+}
+
+// WithContext returns a copy of the Client making its requests with ctx
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return New(c.session.WithContext(ctx))
+}
+
 // Init sets the GTM edgegrid Config
 func Init(config edgegrid.Config) {
 
@@ -32,3 +52,38 @@ func printHttpResponse(res *http.Response, body bool) {
 	edgegrid.PrintHttpResponse(res, body)
 
 }
+
+// DomainService describes the domain operations of a Client, for consumers to
+// depend on instead of the Client, and to substitute a Fake in their tests
+type DomainService interface {
+	ListDomains() ([]*DomainItem, error)
+	GetDomain(domainName string) (*Domain, error)
+	CreateDomain(domain *Domain, queryArgs map[string]string) (*DomainResponse, error)
+	UpdateDomain(domain *Domain, queryArgs map[string]string) (*ResponseStatus, error)
+	DeleteDomain(domain *Domain) (*ResponseStatus, error)
+	GetDomainStatus(domainName string) (*ResponseStatus, error)
+}
+
+// PropertyService describes the property operations of a Client
+type PropertyService interface {
+	ListProperties(domainName string) ([]*Property, error)
+	GetProperty(name, domainName string) (*Property, error)
+	CreateProperty(property *Property, domainName string) (*PropertyResponse, error)
+	UpdateProperty(property *Property, domainName string) (*ResponseStatus, error)
+	DeleteProperty(property *Property, domainName string) (*ResponseStatus, error)
+}
+
+// DatacenterService describes the datacenter operations of a Client
+type DatacenterService interface {
+	ListDatacenters(domainName string) ([]*Datacenter, error)
+	GetDatacenter(dcID int, domainName string) (*Datacenter, error)
+	CreateDatacenter(dc *Datacenter, domainName string) (*DatacenterResponse, error)
+	UpdateDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error)
+	DeleteDatacenter(dc *Datacenter, domainName string) (*ResponseStatus, error)
+}
+
+var (
+	_ DomainService     = (*Client)(nil)
+	_ PropertyService   = (*Client)(nil)
+	_ DatacenterService = (*Client)(nil)
+)
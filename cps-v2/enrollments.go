@@ -51,12 +51,19 @@ func formatTime(t time.Time) string {
 	return fmt.Sprintf("%d-%02d-%02d", t.Year(), t.Month(), t.Day())
 }
 
+// Create an Enrollment on CPS
+//
+// See: Client.Create()
+func (enrollment *Enrollment) Create(params CreateEnrollmentQueryParams) (*CreateEnrollmentResponse, error) {
+	return defaultClient.Create(enrollment, params)
+}
+
 // Create an Enrollment on CPS
 //
 //
 // API Docs: https://developer.akamai.com/api/core_features/certificate_provisioning_system/v2.html#5aaa335c
 // Endpoint: POST /cps/v2/enrollments{?contractId,deploy-not-after,deploy-not-before}
-func (enrollment *Enrollment) Create(params CreateEnrollmentQueryParams) (*CreateEnrollmentResponse, error) {
+func (c *Client) Create(enrollment *Enrollment, params CreateEnrollmentQueryParams) (*CreateEnrollmentResponse, error) {
 	var request = fmt.Sprintf(
 		"/cps/v2/enrollments?contractId=%s",
 		params.ContractID,
@@ -78,7 +85,7 @@ func (enrollment *Enrollment) Create(params CreateEnrollmentQueryParams) (*Creat
 		)
 	}
 
-	req, err := newRequest(
+	req, err := c.newRequest(
 		"POST",
 		request,
 		enrollment,
@@ -88,7 +95,7 @@ func (enrollment *Enrollment) Create(params CreateEnrollmentQueryParams) (*Creat
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -111,9 +118,8 @@ func (enrollment *Enrollment) Create(params CreateEnrollmentQueryParams) (*Creat
 //
 // API Docs: https://developer.akamai.com/api/core_features/certificate_provisioning_system/v2.html#getasingleenrollment
 // Endpoint: POST /cps/v2/enrollments/{enrollmentId}
-func GetEnrollment(location string) (*Enrollment, error) {
-	req, err := client.NewRequest(
-		Config,
+func (c *Client) GetEnrollment(location string) (*Enrollment, error) {
+	req, err := c.session.NewRequest(
 		"GET",
 		location,
 		nil,
@@ -125,7 +131,7 @@ func GetEnrollment(location string) (*Enrollment, error) {
 
 	req.Header.Add("Accept", "application/vnd.akamai.cps.enrollment.v7+json")
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -143,11 +149,17 @@ func GetEnrollment(location string) (*Enrollment, error) {
 	return &response, nil
 }
 
-func ListEnrollments(params ListEnrollmentsQueryParams) ([]Enrollment, error) {
+// Get an enrollment by location
+//
+// See: Client.GetEnrollment()
+func GetEnrollment(location string) (*Enrollment, error) {
+	return defaultClient.GetEnrollment(location)
+}
+
+func (c *Client) ListEnrollments(params ListEnrollmentsQueryParams) ([]Enrollment, error) {
 	var enrollments []Enrollment
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/cps/v2/enrollments?contractId={%s}",
@@ -159,7 +171,7 @@ func ListEnrollments(params ListEnrollmentsQueryParams) ([]Enrollment, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +187,10 @@ func ListEnrollments(params ListEnrollmentsQueryParams) ([]Enrollment, error) {
 	return enrollments, nil
 }
 
+func ListEnrollments(params ListEnrollmentsQueryParams) ([]Enrollment, error) {
+	return defaultClient.ListEnrollments(params)
+}
+
 func (enrollment *Enrollment) Exists(enrollments []Enrollment) bool {
 	for _, e := range enrollments {
 		if e.CertificateSigningRequest.CommonName == enrollment.CertificateSigningRequest.CommonName {
@@ -186,11 +202,18 @@ func (enrollment *Enrollment) Exists(enrollments []Enrollment) bool {
 }
 
 // CreateEnrollment wraps enrollment.Create to accept json
-func CreateEnrollment(data []byte, params CreateEnrollmentQueryParams) (*CreateEnrollmentResponse, error) {
+func (c *Client) CreateEnrollment(data []byte, params CreateEnrollmentQueryParams) (*CreateEnrollmentResponse, error) {
 	var enrollment Enrollment
 	if err := json.Unmarshal(data, &enrollment); err != nil {
 		return nil, err
 	}
 
-	return enrollment.Create(params)
+	return c.Create(&enrollment, params)
+}
+
+// CreateEnrollment wraps enrollment.Create to accept json
+//
+// See: Client.CreateEnrollment()
+func CreateEnrollment(data []byte, params CreateEnrollmentQueryParams) (*CreateEnrollmentResponse, error) {
+	return defaultClient.CreateEnrollment(data, params)
 }
@@ -2,8 +2,9 @@ package cps
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"log"
+	"fmt"
 	"net/http"
 
 	client "github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
@@ -14,26 +15,48 @@ var (
 	// Config contains the Akamai OPEN Edgegrid API credentials
 	// for automatic signing of requests
 	Config edgegrid.Config
+
+	// defaultClient is used by the package-level functions and methods, with Config
+	defaultClient = New(client.NewLiveSession(&Config))
 )
 
+// Client is a CPS client bound to a client.Session
+type Client struct {
+	session *client.Session
+}
+
+// New creates a new CPS Client using session
+func New(session *client.Session) *Client {
+	return &Client{session: session}
+}
+
+// WithContext returns a copy of the Client making its requests with ctx
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return New(c.session.WithContext(ctx))
+}
+
 // Init sets the CPS edgegrid Config
 func Init(config edgegrid.Config) {
 	Config = config
 }
 
-func newRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+func (c *Client) newRequest(method, urlStr string, body interface{}) (*http.Request, error) {
 	buf := new(bytes.Buffer)
 	err := json.NewEncoder(buf).Encode(body)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Printf("[DEBUG] newRequest, buf: %s", string(buf.Bytes()))
+	size := buf.Len()
 
-	req, err := client.NewRequest(Config, method, urlStr, buf)
+	req, err := c.session.NewRequest(method, urlStr, buf)
 	if err != nil {
 		return nil, err
 	}
+	// The body is only dumped, redacted, by the Print* helpers at trace level
+	if logger := edgegrid.LoggerFromContext(req.Context()); edgegrid.LogEnabled(logger, edgegrid.LogLevelDebug) {
+		logger.Debug(fmt.Sprintf("newRequest, %d bytes body", size), edgegrid.RequestLogFields(req))
+	}
 	req.Header.Add("Content-Type", "application/vnd.akamai.cps.enrollment.v7+json")
 	req.Header.Add("Accept", "application/vnd.akamai.cps.enrollment-status.v1+json")
 
@@ -0,0 +1,30 @@
+package cps
+
+import (
+	"testing"
+
+	client "github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Debug(msg string, _ edgegrid.Fields) { l.messages = append(l.messages, msg) }
+func (l *recordingLogger) Info(msg string, _ edgegrid.Fields)  { l.messages = append(l.messages, msg) }
+func (l *recordingLogger) Warn(msg string, _ edgegrid.Fields)  { l.messages = append(l.messages, msg) }
+func (l *recordingLogger) Error(msg string, _ edgegrid.Fields) { l.messages = append(l.messages, msg) }
+
+func TestNewRequest_DoesNotLogBody(t *testing.T) {
+	logger := &recordingLogger{}
+	session := client.NewSession(edgegrid.Config{Host: "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net"})
+	session.Logger = logger
+
+	_, err := New(session).newRequest("POST", "/cps/v2/enrollments", map[string]string{"email": "admin@example.com"})
+	assert.NoError(t, err)
+	if assert.Len(t, logger.messages, 1) {
+		assert.Equal(t, "newRequest, 30 bytes body", logger.messages[0])
+	}
+}
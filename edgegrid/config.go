@@ -1,13 +1,14 @@
 package edgegrid
 
 import (
+	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
-
-	"github.com/mitchellh/go-homedir"
-	"gopkg.in/ini.v1"
 )
 
 // Config struct provides all the necessary fields to
@@ -23,6 +24,90 @@ type Config struct {
 	Debug        bool     `ini:"debug"`
 }
 
+var (
+	akamaiHostRegexp  = regexp.MustCompile(`^akab-[a-z0-9]+-[a-z0-9]+\.luna(-dev)?\.akamaiapis\.net/?$`)
+	akamaiTokenRegexp = regexp.MustCompile(`^akab-[A-Za-z0-9]+(-[A-Za-z0-9]+)+$`)
+	headerNameRegexp  = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+)
+
+// ConfigError describes an invalid Config field
+//
+// Field is the name of the option as used in the .edgerc file, e.g. "client_token".
+type ConfigError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("Invalid %s: %s", e.Field, e.Reason)
+}
+
+// Validate checks the content of the Config fields. The first invalid
+// field is reported as a *ConfigError.
+func (c Config) Validate() error {
+	if err := validateHost(c.Host); err != nil {
+		return err
+	}
+	if !akamaiTokenRegexp.MatchString(c.ClientToken) {
+		return &ConfigError{Field: "client_token", Reason: "must be in the akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx format"}
+	}
+	if c.ClientSecret == "" {
+		return &ConfigError{Field: "client_secret", Reason: "must not be empty"}
+	}
+	if _, err := base64.StdEncoding.DecodeString(c.ClientSecret); err != nil {
+		return &ConfigError{Field: "client_secret", Reason: "must be base64 encoded"}
+	}
+	if !akamaiTokenRegexp.MatchString(c.AccessToken) {
+		return &ConfigError{Field: "access_token", Reason: "must be in the akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx format"}
+	}
+	if c.MaxBody <= 0 {
+		return &ConfigError{Field: "max_body", Reason: fmt.Sprintf("must be positive, got %d", c.MaxBody)}
+	}
+	seen := make(map[string]bool)
+	for _, header := range c.HeaderToSign {
+		switch {
+		case !headerNameRegexp.MatchString(header):
+			return &ConfigError{Field: "headers_to_sign", Reason: fmt.Sprintf("%q is not a valid header name", header)}
+		case http.CanonicalHeaderKey(header) != header:
+			return &ConfigError{Field: "headers_to_sign", Reason: fmt.Sprintf("%q must be written as %q", header, http.CanonicalHeaderKey(header))}
+		case seen[header]:
+			return &ConfigError{Field: "headers_to_sign", Reason: fmt.Sprintf("%q is listed more than once", header)}
+		}
+		seen[header] = true
+	}
+	return nil
+}
+
+// validateHost accepts either an akab-*.luna.akamaiapis.net host name, or
+// any valid host, with an optional https:// scheme
+func validateHost(host string) error {
+	if host == "" {
+		return &ConfigError{Field: "host", Reason: "must not be empty"}
+	}
+	if akamaiHostRegexp.MatchString(host) {
+		return nil
+	}
+
+	raw := host
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return &ConfigError{Field: "host", Reason: err.Error()}
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return &ConfigError{Field: "host", Reason: fmt.Sprintf("unsupported scheme %q", u.Scheme)}
+	}
+	if u.Hostname() == "" || strings.ContainsAny(u.Hostname(), " \t") {
+		return &ConfigError{Field: "host", Reason: fmt.Sprintf("%q is not a valid host", host)}
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return &ConfigError{Field: "host", Reason: "must not contain a path, query or fragment"}
+	}
+	return nil
+}
+
 // Init initializes by first attempting to use ENV vars, with .edgerc as a fallback
 //
 // See: InitEnv()
@@ -66,53 +151,14 @@ func Init(filepath string, section string) (Config, error) {
 // InitEdgeRc initializes using a configuration file in standard INI format
 //
 // By default, it uses the .edgerc found in the users home directory, and the
-// "default" section.
+// "default" section. Sections can inherit from other sections and files can
+// include other files, see LoadEdgeRc().
 func InitEdgeRc(filepath string, section string) (Config, error) {
-	var (
-		c               Config
-		requiredOptions = []string{"host", "client_token", "client_secret", "access_token"}
-		missing         []string
-	)
-
-	// Check if filepath is empty
-	if filepath == "" {
-		filepath = "~/.edgerc"
-	}
-
-	// Check if section is empty
-	if section == "" {
-		section = "default"
-	}
-
-	// Tilde seems to be not working when passing ~/.edgerc as file
-	// Takes current user and use home dir instead
-
-	path, err := homedir.Expand(filepath)
-
-	if err != nil {
-		return c, fmt.Errorf(errorMap[ErrHomeDirNotFound], err)
-	}
-
-	edgerc, err := ini.Load(path)
-	if err != nil {
-		return c, fmt.Errorf(errorMap[ErrConfigFile], err)
-	}
-	err = edgerc.Section(section).MapTo(&c)
-	if err != nil {
-		return c, fmt.Errorf(errorMap[ErrConfigFileSection], err)
-	}
-	for _, opt := range requiredOptions {
-		if !(edgerc.Section(section).HasKey(opt)) {
-			missing = append(missing, opt)
-		}
-	}
-	if len(missing) > 0 {
-		return c, fmt.Errorf(errorMap[ErrConfigMissingOptions], missing)
+	profile, err := LoadEdgeRc(filepath, section)
+	if profile == nil {
+		return Config{}, err
 	}
-	if c.MaxBody == 0 {
-		c.MaxBody = 131072
-	}
-	return c, nil
+	return profile.Config, err
 }
 
 // InitEnv initializes using the Environment (ENV)
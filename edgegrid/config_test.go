@@ -1,6 +1,7 @@
 package edgegrid
 
 import (
+	"errors"
 	"os"
 	"testing"
 
@@ -193,3 +194,75 @@ func TestInitEdgeRc_NoDefault(t *testing.T) {
 	assert.Equal(t, c.MaxBody, 131072)
 	assert.Equal(t, c.HeaderToSign, []string(nil))
 }
+
+func TestConfig_Validate(t *testing.T) {
+	valid := Config{
+		Host:         "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net",
+		ClientToken:  "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		AccessToken:  "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx",
+		MaxBody:      131072,
+		HeaderToSign: []string{"X-Test1", "X-Test2"},
+	}
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, config.Validate())
+
+	tests := map[string]struct {
+		modify func(c *Config)
+		field  string
+	}{
+		"empty host":         {func(c *Config) { c.Host = "" }, "host"},
+		"host with path":     {func(c *Config) { c.Host = "https://example.com/papi/v1" }, "host"},
+		"host with scheme":   {func(c *Config) { c.Host = "ftp://example.com" }, "host"},
+		"bad client token":   {func(c *Config) { c.ClientToken = "xxxx-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx" }, "client_token"},
+		"empty secret":       {func(c *Config) { c.ClientSecret = "" }, "client_secret"},
+		"bad secret":         {func(c *Config) { c.ClientSecret = "not base64!" }, "client_secret"},
+		"bad access token":   {func(c *Config) { c.AccessToken = "akab" }, "access_token"},
+		"zero max body":      {func(c *Config) { c.MaxBody = 0 }, "max_body"},
+		"header with space":  {func(c *Config) { c.HeaderToSign = []string{"X-Test1", " X-Test2"} }, "headers_to_sign"},
+		"lowercase header":   {func(c *Config) { c.HeaderToSign = []string{"x-test1"} }, "headers_to_sign"},
+		"duplicate header":   {func(c *Config) { c.HeaderToSign = []string{"X-Test1", "X-Test1"} }, "headers_to_sign"},
+		"empty header entry": {func(c *Config) { c.HeaderToSign = []string{""} }, "headers_to_sign"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := valid
+			test.modify(&c)
+			err := c.Validate()
+
+			var configErr *ConfigError
+			if assert.True(t, errors.As(err, &configErr), "expected *ConfigError, got %v", err) {
+				assert.Equal(t, test.field, configErr.Field)
+			}
+		})
+	}
+}
+
+func TestLoadEdgeRc_Inheritance(t *testing.T) {
+	profile, err := LoadEdgeRc("../testdata/inherit_edgerc", "ccu-staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "akab-ccuxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net", profile.Config.Host)
+	assert.Equal(t, "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx", profile.Config.ClientToken)
+	assert.Equal(t, "1-EFGH", profile.Config.AccountKey)
+	assert.Equal(t, 2048, profile.Config.MaxBody)
+
+	assert.Equal(t, EdgeRcOrigin{File: "../testdata/inherit_edgerc", Section: "ccu-staging"}, profile.Origins["account_key"])
+	assert.Equal(t, EdgeRcOrigin{File: "../testdata/inherit_edgerc", Section: "ccu"}, profile.Origins["host"])
+	assert.Equal(t, EdgeRcOrigin{File: "../testdata/inherit_edgerc", Section: "default"}, profile.Origins["max_body"])
+	assert.Equal(t, EdgeRcOrigin{File: "../testdata/shared_edgerc", Section: "default"}, profile.Origins["client_secret"])
+
+	c, err := InitEdgeRc("../testdata/inherit_edgerc", "ccu")
+	assert.NoError(t, err)
+	assert.Equal(t, "1-ABCD", c.AccountKey)
+}
+
+func TestLoadEdgeRc_InheritanceErrors(t *testing.T) {
+	_, err := InitEdgeRc("../testdata/inherit_edgerc", "orphan")
+	assert.EqualError(t, err, `Invalid section inheritance: section "orphan" inherits from unknown section "missing"`)
+
+	_, err = InitEdgeRc("../testdata/inherit_edgerc", "loop-a")
+	assert.EqualError(t, err, "Invalid section inheritance: cycle loop-a -> loop-b -> loop-a")
+
+	_, err = InitEdgeRc("../testdata/include_loop_edgerc", "")
+	assert.Error(t, err)
+}
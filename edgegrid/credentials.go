@@ -0,0 +1,282 @@
+package edgegrid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCredentialTTL is how long ProcessProvider caches credentials which
+	// do not specify their own expiration, used when ProcessProvider.TTL is zero
+	DefaultCredentialTTL = 5 * time.Minute
+
+	// DefaultCredentialProcessTimeout is the maximum run time of a credential process,
+	// used when ProcessProvider.Timeout is zero
+	DefaultCredentialProcessTimeout = time.Minute
+
+	defaultMaxBody = 131072
+)
+
+// CredentialProvider supplies the credentials used to sign requests.
+//
+// Retrieve is called before each request is signed, so implementations
+// are expected to cache expensive lookups themselves.
+type CredentialProvider interface {
+	Retrieve() (Config, error)
+}
+
+// StaticProvider provides in-memory credentials
+type StaticProvider struct {
+	Config Config
+}
+
+// Retrieve implements CredentialProvider
+func (p *StaticProvider) Retrieve() (Config, error) {
+	return p.Config, nil
+}
+
+// EnvProvider provides credentials from AKAMAI_* environment variables
+//
+// See: InitEnv()
+type EnvProvider struct {
+	Section string
+}
+
+// Retrieve implements CredentialProvider
+func (p *EnvProvider) Retrieve() (Config, error) {
+	return InitEnv(p.Section)
+}
+
+// EdgeRcProvider provides credentials from a section of an .edgerc file.
+//
+// The credentials are cached until the file, or one of the files it includes,
+// is modified.
+//
+// See: InitEdgeRc()
+type EdgeRcProvider struct {
+	Path    string
+	Section string
+
+	mu     sync.Mutex
+	config Config
+	files  map[string]fileVersion
+}
+
+// fileVersion identifies the content of a file read by EdgeRcProvider
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// Retrieve implements CredentialProvider
+func (p *EdgeRcProvider) Retrieve() (Config, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.files != nil && !filesModified(p.files) {
+		return p.config, nil
+	}
+
+	profile, files, err := loadEdgeRc(p.Path, p.Section)
+	if err != nil {
+		p.files = nil
+		if profile == nil {
+			return Config{}, err
+		}
+		return profile.Config, err
+	}
+
+	p.config, p.files = profile.Config, fileVersions(files)
+	return p.config, nil
+}
+
+// fileVersions returns the current version of each of paths, the zero
+// version for those which cannot be read, reported as modified
+func fileVersions(paths []string) map[string]fileVersion {
+	versions := make(map[string]fileVersion, len(paths))
+	for _, path := range paths {
+		var version fileVersion
+		if info, err := os.Stat(path); err == nil {
+			version = fileVersion{modTime: info.ModTime(), size: info.Size()}
+		}
+		versions[path] = version
+	}
+	return versions
+}
+
+// filesModified tells whether any of the files changed since versions were taken
+func filesModified(versions map[string]fileVersion) bool {
+	for path, version := range versions {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(version.modTime) || info.Size() != version.size {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessProvider provides credentials printed as JSON by an external command,
+// similar to the credential_process of other tools:
+//
+//	{
+//		"host": "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net",
+//		"client_token": "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx",
+//		"client_secret": "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+//		"access_token": "akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx",
+//		"account_key": "",
+//		"max_body": 131072,
+//		"headers_to_sign": [],
+//		"expiration": "2020-10-15T12:00:00Z"
+//	}
+//
+// The credentials are cached until their expiration, or for TTL if the command
+// does not report one.
+type ProcessProvider struct {
+	// Command is the command line to run. It is split on white space and
+	// executed directly, not through a shell.
+	Command string
+
+	// TTL defaults to DefaultCredentialTTL
+	TTL time.Duration
+
+	// Timeout defaults to DefaultCredentialProcessTimeout
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	config  Config
+	expires time.Time
+}
+
+type processCredentials struct {
+	Host         string    `json:"host"`
+	ClientToken  string    `json:"client_token"`
+	ClientSecret string    `json:"client_secret"`
+	AccessToken  string    `json:"access_token"`
+	AccountKey   string    `json:"account_key"`
+	HeaderToSign []string  `json:"headers_to_sign"`
+	MaxBody      int       `json:"max_body"`
+	Expiration   time.Time `json:"expiration"`
+}
+
+// Retrieve implements CredentialProvider
+func (p *ProcessProvider) Retrieve() (Config, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.expires.IsZero() && time.Now().Before(p.expires) {
+		return p.config, nil
+	}
+
+	creds, err := p.run()
+	if err != nil {
+		return Config{}, err
+	}
+
+	var missing []string
+	for _, opt := range []struct{ name, value string }{
+		{"host", creds.Host},
+		{"client_token", creds.ClientToken},
+		{"client_secret", creds.ClientSecret},
+		{"access_token", creds.AccessToken},
+	} {
+		if opt.value == "" {
+			missing = append(missing, opt.name)
+		}
+	}
+	if len(missing) > 0 {
+		return Config{}, fmt.Errorf(errorMap[ErrConfigMissingOptions], missing)
+	}
+
+	p.config = Config{
+		Host:         creds.Host,
+		ClientToken:  creds.ClientToken,
+		ClientSecret: creds.ClientSecret,
+		AccessToken:  creds.AccessToken,
+		AccountKey:   creds.AccountKey,
+		HeaderToSign: creds.HeaderToSign,
+		MaxBody:      creds.MaxBody,
+	}
+	if p.config.MaxBody == 0 {
+		p.config.MaxBody = defaultMaxBody
+	}
+
+	p.expires = creds.Expiration
+	if p.expires.IsZero() {
+		ttl := p.TTL
+		if ttl == 0 {
+			ttl = DefaultCredentialTTL
+		}
+		p.expires = time.Now().Add(ttl)
+	}
+
+	return p.config, nil
+}
+
+func (p *ProcessProvider) run() (*processCredentials, error) {
+	args := strings.Fields(p.Command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf(errorMap[ErrCredentialProcess], "empty command")
+	}
+
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = DefaultCredentialProcessTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf(errorMap[ErrCredentialProcess], fmt.Sprintf("%s: %s", err, strings.TrimSpace(stderr.String())))
+	}
+
+	creds := &processCredentials{}
+	if err := json.Unmarshal(out, creds); err != nil {
+		return nil, fmt.Errorf(errorMap[ErrCredentialProcess], err)
+	}
+	return creds, nil
+}
+
+// ChainProvider tries each of its providers in order and returns the
+// credentials of the first one that succeeds
+type ChainProvider struct {
+	Providers []CredentialProvider
+}
+
+// NewChainProvider creates a new ChainProvider
+func NewChainProvider(providers ...CredentialProvider) *ChainProvider {
+	return &ChainProvider{Providers: providers}
+}
+
+// DefaultProvider returns the chain used by Init(): the environment first,
+// with the given .edgerc file and section as a fallback. The file is only
+// parsed again once modified.
+func DefaultProvider(filepath string, section string) *ChainProvider {
+	return NewChainProvider(
+		&EnvProvider{Section: section},
+		&EdgeRcProvider{Path: filepath, Section: strings.ToLower(section)},
+	)
+}
+
+// Retrieve implements CredentialProvider
+func (p *ChainProvider) Retrieve() (Config, error) {
+	var errs []string
+	for _, provider := range p.Providers {
+		config, err := provider.Retrieve()
+		if err == nil {
+			return config, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return Config{}, fmt.Errorf(errorMap[ErrNoCredentials], strings.Join(errs, "; "))
+}
@@ -0,0 +1,144 @@
+package edgegrid
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeCredentialProcess(t *testing.T, output string) (string, func()) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process test script requires a POSIX shell")
+	}
+	dir, err := ioutil.TempDir("", "edgegrid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := filepath.Join(dir, "credentials.sh")
+	counter := filepath.Join(dir, "runs")
+	err = ioutil.WriteFile(script, []byte("#!/bin/sh\necho run >> "+counter+"\ncat <<'EOF'\n"+output+"\nEOF\n"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return script, func() { os.RemoveAll(dir) }
+}
+
+func TestStaticProvider(t *testing.T) {
+	c, err := (&StaticProvider{Config: config}).Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, config, c)
+}
+
+func TestChainProvider(t *testing.T) {
+	os.Clearenv()
+
+	chain := DefaultProvider("../testdata/sample_edgerc", "TEST")
+	c, err := chain.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "test-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net/", c.Host)
+
+	os.Setenv("AKAMAI_TEST_HOST", "env-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net/")
+	os.Setenv("AKAMAI_TEST_CLIENT_TOKEN", "env-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx")
+	os.Setenv("AKAMAI_TEST_CLIENT_SECRET", "envxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=")
+	os.Setenv("AKAMAI_TEST_ACCESS_TOKEN", "env-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx")
+	defer os.Clearenv()
+
+	c, err = chain.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "env-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net/", c.Host)
+}
+
+func TestChainProvider_NoCredentials(t *testing.T) {
+	os.Clearenv()
+
+	_, err := DefaultProvider("edgerc_not_found", "").Retrieve()
+	assert.Error(t, err)
+}
+
+func TestEdgeRcProvider_Cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edgegrid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	edgerc := filepath.Join(dir, "edgerc")
+	shared := filepath.Join(dir, "shared")
+	write := func(path, content string, modTime time.Time) {
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	then := time.Now().Add(-time.Hour)
+	write(shared, "[default]\nhost = shared-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net\n", then)
+	write(edgerc, "include = shared\n\n[test : default]\nclient_token = a\nclient_secret = a\naccess_token = a\n", then)
+
+	provider := &EdgeRcProvider{Path: edgerc, Section: "test"}
+	c, err := provider.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", c.ClientToken)
+
+	// Unmodified files are not read again
+	write(edgerc, "include = shared\n\n[test : default]\nclient_token = b\nclient_secret = b\naccess_token = b\n", then)
+	c, err = provider.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", c.ClientToken)
+
+	// A modified include reloads the credentials
+	write(shared, "[default]\nhost = rotated-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net\n", time.Now())
+	c, err = provider.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net", c.Host)
+	assert.Equal(t, "b", c.ClientToken)
+}
+
+func TestProcessProvider(t *testing.T) {
+	script, cleanup := writeCredentialProcess(t, `{
+		"host": "process-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net",
+		"client_token": "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		"client_secret": "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx=",
+		"access_token": "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		"headers_to_sign": ["X-Test1"]
+	}`)
+	defer cleanup()
+
+	provider := &ProcessProvider{Command: script, TTL: time.Hour}
+	c, err := provider.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "process-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net", c.Host)
+	assert.Equal(t, []string{"X-Test1"}, c.HeaderToSign)
+	assert.Equal(t, 131072, c.MaxBody)
+
+	_, err = provider.Retrieve()
+	assert.NoError(t, err)
+	runs, _ := ioutil.ReadFile(filepath.Join(filepath.Dir(script), "runs"))
+	assert.Equal(t, "run\n", string(runs), "credentials must be cached")
+
+	signer := NewProviderSigner(provider)
+	req, _ := http.NewRequest("GET", "https://"+c.Host+"/papi/v1/groups", nil)
+	assert.NoError(t, signer.Sign(req))
+	assert.NoError(t, Verify(c, req))
+}
+
+func TestProcessProvider_Errors(t *testing.T) {
+	script, cleanup := writeCredentialProcess(t, `{"host": "process-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net"}`)
+	defer cleanup()
+
+	_, err := (&ProcessProvider{Command: script}).Retrieve()
+	assert.EqualError(t, err, "Fatal missing required options: [client_token client_secret access_token]")
+
+	_, err = (&ProcessProvider{Command: ""}).Retrieve()
+	assert.Error(t, err)
+
+	_, err = (&ProcessProvider{Command: filepath.Join(filepath.Dir(script), "missing")}).Retrieve()
+	assert.Error(t, err)
+}
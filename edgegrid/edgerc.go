@@ -0,0 +1,216 @@
+package edgegrid
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"gopkg.in/ini.v1"
+)
+
+// The .edgerc loader supports two extensions to the plain INI format.
+//
+// A section can inherit from another one by naming its parent after a colon.
+// Only the options which differ have to be repeated:
+//
+//	[default]
+//	host = akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net
+//	client_token = akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx
+//	...
+//
+//	[ccu : default]
+//	account_key = 1-ABCD
+//
+// An include option placed before the first section pulls in the sections of
+// other files, given as a comma separated list of paths relative to the including
+// file. Included files are loaded first, so the including file can override them:
+//
+//	include = ~/.edgerc.shared, accounts.edgerc
+const edgercInclude = "include"
+
+// EdgeRcOrigin tells where a resolved .edgerc option was defined
+type EdgeRcOrigin struct {
+	File    string
+	Section string
+}
+
+func (o EdgeRcOrigin) String() string {
+	return fmt.Sprintf("%s [%s]", o.File, o.Section)
+}
+
+// EdgeRcProfile is a section of an .edgerc file, with its includes and
+// inheritance resolved
+type EdgeRcProfile struct {
+	Config Config
+
+	// Origins tells for each option set in the file, e.g. "host", where its
+	// value came from. Defaulted options are not listed.
+	Origins map[string]EdgeRcOrigin
+}
+
+// edgercSection is one definition of a section, as written in a single file
+type edgercSection struct {
+	file    string
+	parent  string
+	section *ini.Section
+}
+
+// LoadEdgeRc loads a section of an .edgerc file, the way InitEdgeRc does,
+// and reports where each value came from.
+//
+// If required options are missing, both the profile and an error are returned.
+func LoadEdgeRc(filepath string, section string) (*EdgeRcProfile, error) {
+	profile, _, err := loadEdgeRc(filepath, section)
+	return profile, err
+}
+
+// loadEdgeRc loads a section like LoadEdgeRc, also returning the paths of
+// the files read, the .edgerc file first
+func loadEdgeRc(filepath string, section string) (*EdgeRcProfile, []string, error) {
+	var (
+		c               Config
+		requiredOptions = []string{"host", "client_token", "client_secret", "access_token"}
+		missing         []string
+	)
+
+	// Check if filepath is empty
+	if filepath == "" {
+		filepath = "~/.edgerc"
+	}
+
+	// Check if section is empty
+	if section == "" {
+		section = "default"
+	}
+
+	// Tilde seems to be not working when passing ~/.edgerc as file
+	// Takes current user and use home dir instead
+
+	path, err := homedir.Expand(filepath)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf(errorMap[ErrHomeDirNotFound], err)
+	}
+
+	sections := make(map[string][]*edgercSection)
+	var files []string
+	if err := loadEdgeRcFile(path, sections, &files, nil); err != nil {
+		return nil, files, err
+	}
+
+	resolved, origins, err := resolveEdgeRcSection(sections, section)
+	if err != nil {
+		return nil, files, err
+	}
+
+	err = resolved.MapTo(&c)
+	if err != nil {
+		return nil, files, fmt.Errorf(errorMap[ErrConfigFileSection], err)
+	}
+	profile := &EdgeRcProfile{Config: c, Origins: origins}
+
+	for _, opt := range requiredOptions {
+		if !(resolved.HasKey(opt)) {
+			missing = append(missing, opt)
+		}
+	}
+	if len(missing) > 0 {
+		return profile, files, fmt.Errorf(errorMap[ErrConfigMissingOptions], missing)
+	}
+	if profile.Config.MaxBody == 0 {
+		profile.Config.MaxBody = defaultMaxBody
+	}
+	return profile, files, nil
+}
+
+// loadEdgeRcFile adds the sections defined in path, and in the files it
+// includes, to sections, and the files read to files. stack holds the files
+// currently being included.
+func loadEdgeRcFile(path string, sections map[string][]*edgercSection, files *[]string, stack []string) error {
+	for _, including := range stack {
+		if including == path {
+			return fmt.Errorf(errorMap[ErrConfigInclude], fmt.Sprintf("%s includes itself", path))
+		}
+	}
+
+	*files = append(*files, path)
+	edgerc, err := ini.Load(path)
+	if err != nil {
+		if len(stack) > 0 {
+			return fmt.Errorf(errorMap[ErrConfigInclude], err)
+		}
+		return fmt.Errorf(errorMap[ErrConfigFile], err)
+	}
+
+	for _, include := range edgerc.Section(ini.DefaultSection).Key(edgercInclude).Strings(",") {
+		includePath, err := homedir.Expand(include)
+		if err != nil {
+			return fmt.Errorf(errorMap[ErrHomeDirNotFound], err)
+		}
+		if !filepath.IsAbs(includePath) {
+			includePath = filepath.Join(filepath.Dir(path), includePath)
+		}
+		if err := loadEdgeRcFile(includePath, sections, files, append(stack, path)); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range edgerc.Sections() {
+		if s.Name() == ini.DefaultSection {
+			continue
+		}
+		name, parent := s.Name(), ""
+		if parts := strings.SplitN(name, ":", 2); len(parts) == 2 {
+			name, parent = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		}
+		sections[name] = append(sections[name], &edgercSection{file: path, parent: parent, section: s})
+	}
+
+	return nil
+}
+
+// resolveEdgeRcSection merges the definitions of name and of its ancestors
+// into a single section, the child values overriding the parent ones
+func resolveEdgeRcSection(sections map[string][]*edgercSection, name string) (*ini.Section, map[string]EdgeRcOrigin, error) {
+	var chain []string
+	for current := name; current != ""; {
+		for _, seen := range chain {
+			if seen == current {
+				return nil, nil, fmt.Errorf(errorMap[ErrConfigInheritance], fmt.Sprintf("cycle %s -> %s", strings.Join(chain, " -> "), current))
+			}
+		}
+		defs, ok := sections[current]
+		if !ok && current != name {
+			return nil, nil, fmt.Errorf(errorMap[ErrConfigInheritance], fmt.Sprintf("section %q inherits from unknown section %q", chain[len(chain)-1], current))
+		}
+		chain = append(chain, current)
+
+		current = ""
+		for _, def := range defs {
+			if def.parent != "" {
+				current = def.parent
+			}
+		}
+	}
+
+	resolved, err := ini.Empty().NewSection(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errorMap[ErrConfigFileSection], err)
+	}
+	origins := make(map[string]EdgeRcOrigin)
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, def := range sections[chain[i]] {
+			for _, key := range def.section.Keys() {
+				if resolved.HasKey(key.Name()) {
+					resolved.Key(key.Name()).SetValue(key.Value())
+				} else if _, err := resolved.NewKey(key.Name(), key.Value()); err != nil {
+					return nil, nil, fmt.Errorf(errorMap[ErrConfigFileSection], err)
+				}
+				origins[key.Name()] = EdgeRcOrigin{File: def.file, Section: chain[i]}
+			}
+		}
+	}
+
+	return resolved, origins, nil
+}
@@ -8,6 +8,10 @@ const (
 	ErrConfigFileSection    = 503
 	ErrConfigMissingOptions = 504
 	ErrMissingEnvVariables  = 505
+	ErrCredentialProcess    = 506
+	ErrNoCredentials        = 507
+	ErrConfigInclude        = 508
+	ErrConfigInheritance    = 509
 )
 
 var (
@@ -18,5 +22,9 @@ var (
 		ErrConfigFileSection:    "Could not map section: %s",
 		ErrConfigMissingOptions: "Fatal missing required options: %s",
 		ErrMissingEnvVariables:  "Fatal missing required environment variables: %s",
+		ErrCredentialProcess:    "Credential process failed: %s",
+		ErrNoCredentials:        "No credentials found in provider chain: %s",
+		ErrConfigInclude:        "Could not include edgerc file: %s",
+		ErrConfigInheritance:    "Invalid section inheritance: %s",
 	}
 )
@@ -23,8 +23,7 @@ import (
 	"net/http/httputil"
 	"os"
 	"strings"
-
-	logstd "log"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -33,8 +32,33 @@ var logBuffer *bufio.Writer
 var LogFile *os.File
 var EdgegridLog *log.Logger
 
+// setupLoggingMu guards EdgegridLog, SetupLogging being called by concurrent requests
+var setupLoggingMu sync.Mutex
+
 func SetupLogging() {
+	setupLoggingMu.Lock()
+	defer setupLoggingMu.Unlock()
+
+	setupLogging()
+}
+
+// setupSigningLogging sets up logging like SetupLogging when the first request
+// is signed, at debug level if debug is set
+func setupSigningLogging(debug bool) {
+	setupLoggingMu.Lock()
+	defer setupLoggingMu.Unlock()
+
+	if EdgegridLog != nil {
+		return // already configured
+	}
+	setupLogging()
+	if debug {
+		EdgegridLog.SetLevel(log.DebugLevel)
+	}
+}
 
+// setupLogging configures EdgegridLog, with setupLoggingMu held
+func setupLogging() {
 	if EdgegridLog != nil {
 		return // already configured
 	}
@@ -88,14 +112,23 @@ func LogMultilinef(f func(formatter string, args ...interface{}), formatter stri
 }
 
 // Utility func to print http req
+//
+// The request is dumped line by line at trace level, with the service and
+// operation fields, to the logger of the request context (see LoggerFromContext).
+// Secrets are masked by LogRedactor.
 func PrintHttpRequest(req *http.Request, body bool) {
 
 	if req == nil {
 		return
 	}
+	l, ok := tracer(LoggerFromContext(req.Context()))
+	if !ok {
+		return
+	}
 	b, err := httputil.DumpRequestOut(req, body)
 	if err == nil {
-		LogMultiline(EdgegridLog.Traceln, string(b))
+		b = LogRedactor.Redact(b)
+		logDump(l, RequestLogFields(req), string(b))
 	}
 }
 
@@ -104,22 +137,35 @@ func PrintHttpRequestCorrelation(req *http.Request, body bool, correlationid str
 	if req == nil {
 		return
 	}
+	l, ok := tracer(LoggerFromContext(req.Context()))
+	if !ok {
+		return
+	}
 	b, err := httputil.DumpRequestOut(req, body)
 	if err == nil {
-		LogMultiline(EdgegridLog.Traceln, string(b))
-		PrintfCorrelation("[DEBUG] REQUEST", correlationid, prettyPrintJsonLines(b))
+		b = LogRedactor.Redact(b)
+		logDump(l, withCorrelation(RequestLogFields(req), correlationid), prettyPrintJsonLines(b))
 	}
 }
 
 // Utility func to print http response
+//
+// The response is dumped line by line at trace level, with the service, operation
+// and status fields, to the logger of the request context (see LoggerFromContext).
+// Secrets are masked by LogRedactor.
 func PrintHttpResponse(res *http.Response, body bool) {
 
 	if res == nil {
 		return
 	}
+	l, ok := tracer(responseLogger(res))
+	if !ok {
+		return
+	}
 	b, err := httputil.DumpResponse(res, body)
 	if err == nil {
-		LogMultiline(EdgegridLog.Traceln, string(b))
+		b = LogRedactor.Redact(b)
+		logDump(l, ResponseLogFields(res), string(b))
 	}
 }
 
@@ -128,22 +174,60 @@ func PrintHttpResponseCorrelation(res *http.Response, body bool, correlationid s
 	if res == nil {
 		return
 	}
+	l, ok := tracer(responseLogger(res))
+	if !ok {
+		return
+	}
 	b, err := httputil.DumpResponse(res, body)
 	if err == nil {
-		LogMultiline(EdgegridLog.Traceln, string(b))
-		PrintfCorrelation("[DEBUG] RESPONSE ", correlationid, prettyPrintJsonLines(b))
+		b = LogRedactor.Redact(b)
+		logDump(l, withCorrelation(ResponseLogFields(res), correlationid), prettyPrintJsonLines(b))
 	}
 }
 
+// PrintfCorrelation logs msg through GetLogger(), at the level named in level
+// (e.g. "[DEBUG]" or "[WARN]"), with the correlation ID field
 func PrintfCorrelation(level string, correlationid string, msg string) {
 
-	if correlationid == "" {
-		logstd.Printf("%s  %s\n", level, msg)
-	} else {
-		logstd.SetFlags(0)
-		logstd.Printf("%v %s\n", correlationid, msg)
+	l := GetLogger()
+	fields := withCorrelation(Fields{}, correlationid)
+	switch level = strings.ToUpper(level); {
+	case strings.Contains(level, "ERROR"):
+		l.Error(msg, fields)
+	case strings.Contains(level, "WARN"):
+		l.Warn(msg, fields)
+	case strings.Contains(level, "INFO"):
+		l.Info(msg, fields)
+	default:
+		l.Debug(msg, fields)
 	}
+}
 
+func withCorrelation(fields Fields, correlationid string) Fields {
+	if correlationid != "" {
+		fields[LogFieldCorrelationID] = correlationid
+	}
+	return fields
+}
+
+func responseLogger(res *http.Response) Logger {
+	if res.Request == nil {
+		return GetLogger()
+	}
+	return LoggerFromContext(res.Request.Context())
+}
+
+// tracer returns l as a TraceLogger, and whether it writes trace entries
+func tracer(l Logger) (TraceLogger, bool) {
+	t, ok := l.(TraceLogger)
+	return t, ok && LogEnabled(l, LogLevelTrace)
+}
+
+// logDump logs every line of an HTTP dump at trace level
+func logDump(l TraceLogger, fields Fields, dump string) {
+	for _, line := range strings.Split(strings.Trim(dump, "\r\n"), "\n") {
+		l.Trace(strings.TrimRight(line, "\r"), fields)
+	}
 }
 
 // prettyPrintJsonLines iterates through a []byte line-by-line,
@@ -0,0 +1,261 @@
+package edgegrid
+
+import (
+	"context"
+	"fmt"
+	logstd "log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Field names used by the library when logging API calls
+const (
+	// LogFieldService is the API the call is made to, e.g. "papi" or "config-dns"
+	LogFieldService = "service"
+	// LogFieldOperation is the HTTP method and path of the call, e.g. "GET /papi/v1/groups"
+	LogFieldOperation = "operation"
+	// LogFieldCorrelationID is the correlation ID given by the caller, if any
+	LogFieldCorrelationID = "correlation_id"
+	// LogFieldStatus is the HTTP status code of the response
+	LogFieldStatus = "status"
+	// LogFieldError is the error the call failed with
+	LogFieldError = "error"
+	// LogFieldAttempt is the number of the retry of the call
+	LogFieldAttempt = "attempt"
+)
+
+// Fields are the structured data attached to a log entry
+type Fields map[string]interface{}
+
+// Logger is the logging interface used by the library.
+//
+// Adapters are provided for logrus and the standard log package, and
+// NewNopLogger discards everything. The logger is set with SetLogger.
+type Logger interface {
+	Debug(msg string, fields Fields)
+	Info(msg string, fields Fields)
+	Warn(msg string, fields Fields)
+	Error(msg string, fields Fields)
+}
+
+// Level is the severity of a log entry
+type Level int
+
+// Log levels, from the most verbose
+const (
+	LogLevelTrace Level = iota
+	LogLevelDebug
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+// LevelLogger is a Logger telling whether it writes the entries of a level.
+// The library only builds costly entries, such as the details of the signing
+// of a request, for the levels enabled.
+type LevelLogger interface {
+	Logger
+	Enabled(level Level) bool
+}
+
+// TraceLogger is a Logger writing entries more verbose than debug ones: the
+// HTTP dumps of the Print* helpers, only written to a TraceLogger.
+type TraceLogger interface {
+	Logger
+	Trace(msg string, fields Fields)
+}
+
+// LogEnabled returns true if l writes the entries of level. A Logger which is
+// not a LevelLogger is assumed to write every level.
+func LogEnabled(l Logger, level Level) bool {
+	if l, ok := l.(LevelLogger); ok {
+		return l.Enabled(level)
+	}
+	return true
+}
+
+var (
+	loggerMu sync.RWMutex
+	logger   Logger
+)
+
+// SetLogger sets the logger used by the library. Passing nil restores the
+// default logger, which writes to EdgegridLog.
+func SetLogger(l Logger) {
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+	logger = l
+}
+
+// GetLogger returns the logger used by the library
+//
+// Unless set with SetLogger, this is EdgegridLog, configured from the
+// AKAMAI_LOG and AKAMAI_LOG_FILE environment variables.
+func GetLogger() Logger {
+	loggerMu.RLock()
+	l := logger
+	loggerMu.RUnlock()
+	if l != nil {
+		return l
+	}
+
+	SetupLogging()
+	return NewLogrusLogger(EdgegridLog)
+}
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying l. Requests created with such a
+// context are logged to l by the Print* helpers instead of GetLogger().
+func WithLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// LoggerFromContext returns the logger carried by ctx, or GetLogger() if none
+func LoggerFromContext(ctx context.Context) Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(loggerKey{}).(Logger); ok && l != nil {
+			return l
+		}
+	}
+	return GetLogger()
+}
+
+// RequestLogFields returns the service and operation fields describing req
+func RequestLogFields(req *http.Request) Fields {
+	fields := Fields{}
+	if req == nil || req.URL == nil {
+		return fields
+	}
+
+	path := req.URL.Path
+	if service := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0]; service != "" {
+		fields[LogFieldService] = service
+	}
+	fields[LogFieldOperation] = strings.TrimSpace(req.Method + " " + path)
+	return fields
+}
+
+// ResponseLogFields returns the fields describing the request of res, and its status
+func ResponseLogFields(res *http.Response) Fields {
+	if res == nil {
+		return Fields{}
+	}
+	fields := RequestLogFields(res.Request)
+	fields[LogFieldStatus] = res.StatusCode
+	return fields
+}
+
+// NewLogrusLogger returns a Logger writing to l
+func NewLogrusLogger(l *logrus.Logger) Logger {
+	return &logrusLogger{logger: l}
+}
+
+type logrusLogger struct {
+	logger *logrus.Logger
+}
+
+func (l *logrusLogger) Trace(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Trace(msg)
+}
+
+func (l *logrusLogger) Debug(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Debug(msg)
+}
+
+func (l *logrusLogger) Info(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Info(msg)
+}
+
+func (l *logrusLogger) Warn(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Warn(msg)
+}
+
+func (l *logrusLogger) Error(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Error(msg)
+}
+
+func (l *logrusLogger) Enabled(level Level) bool {
+	switch level {
+	case LogLevelTrace:
+		return l.logger.IsLevelEnabled(logrus.TraceLevel)
+	case LogLevelDebug:
+		return l.logger.IsLevelEnabled(logrus.DebugLevel)
+	case LogLevelInfo:
+		return l.logger.IsLevelEnabled(logrus.InfoLevel)
+	case LogLevelWarn:
+		return l.logger.IsLevelEnabled(logrus.WarnLevel)
+	}
+	return l.logger.IsLevelEnabled(logrus.ErrorLevel)
+}
+
+// NewStdLogger returns a Logger writing to l, or to the standard logger if l
+// is nil. The standard log package has no levels, so every entry is written,
+// prefixed with its level and followed by its fields as sorted key=value pairs.
+func NewStdLogger(l *logstd.Logger) Logger {
+	return &stdLogger{logger: l}
+}
+
+type stdLogger struct {
+	logger *logstd.Logger
+}
+
+func (l *stdLogger) Trace(msg string, fields Fields) {
+	l.print("TRACE", msg, fields)
+}
+
+func (l *stdLogger) Debug(msg string, fields Fields) {
+	l.print("DEBUG", msg, fields)
+}
+
+func (l *stdLogger) Info(msg string, fields Fields) {
+	l.print("INFO", msg, fields)
+}
+
+func (l *stdLogger) Warn(msg string, fields Fields) {
+	l.print("WARN", msg, fields)
+}
+
+func (l *stdLogger) Error(msg string, fields Fields) {
+	l.print("ERROR", msg, fields)
+}
+
+func (l *stdLogger) Enabled(Level) bool {
+	return true
+}
+
+func (l *stdLogger) print(level string, msg string, fields Fields) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	line := fmt.Sprintf("[%s] %s", level, msg)
+	for _, key := range keys {
+		line += fmt.Sprintf(" %s=%v", key, fields[key])
+	}
+
+	if l.logger == nil {
+		logstd.Println(line)
+		return
+	}
+	l.logger.Println(line)
+}
+
+// NewNopLogger returns a Logger discarding every entry
+func NewNopLogger() Logger {
+	return nopLogger{}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, Fields) {}
+func (nopLogger) Info(string, Fields)  {}
+func (nopLogger) Warn(string, Fields)  {}
+func (nopLogger) Error(string, Fields) {}
+func (nopLogger) Enabled(Level) bool   { return false }
@@ -0,0 +1,149 @@
+package edgegrid
+
+import (
+	"bytes"
+	logstd "log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedEntry struct {
+	level  string
+	msg    string
+	fields Fields
+}
+
+type recordingLogger struct {
+	entries []recordedEntry
+}
+
+func (l *recordingLogger) Trace(msg string, fields Fields) { l.record("trace", msg, fields) }
+func (l *recordingLogger) Debug(msg string, fields Fields) { l.record("debug", msg, fields) }
+func (l *recordingLogger) Info(msg string, fields Fields)  { l.record("info", msg, fields) }
+func (l *recordingLogger) Warn(msg string, fields Fields)  { l.record("warn", msg, fields) }
+func (l *recordingLogger) Error(msg string, fields Fields) { l.record("error", msg, fields) }
+
+func (l *recordingLogger) record(level string, msg string, fields Fields) {
+	l.entries = append(l.entries, recordedEntry{level: level, msg: msg, fields: fields})
+}
+
+func TestSetLogger_PrintHttpRequestCorrelation(t *testing.T) {
+	recorder := &recordingLogger{}
+	SetLogger(recorder)
+	defer SetLogger(nil)
+
+	req, _ := http.NewRequest("GET", "https://akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net/papi/v1/groups", nil)
+	PrintHttpRequestCorrelation(req, true, "cid-1")
+
+	if assert.NotEmpty(t, recorder.entries) {
+		entry := recorder.entries[0]
+		assert.Equal(t, "trace", entry.level)
+		assert.Equal(t, "GET /papi/v1/groups HTTP/1.1", entry.msg)
+		assert.Equal(t, Fields{
+			LogFieldService:       "papi",
+			LogFieldOperation:     "GET /papi/v1/groups",
+			LogFieldCorrelationID: "cid-1",
+		}, entry.fields)
+	}
+
+	res := &http.Response{StatusCode: 404, Request: req, ProtoMajor: 1, ProtoMinor: 1, Header: http.Header{}}
+	recorder.entries = nil
+	PrintHttpResponse(res, false)
+	if assert.NotEmpty(t, recorder.entries) {
+		assert.Equal(t, 404, recorder.entries[0].fields[LogFieldStatus])
+		assert.NotContains(t, recorder.entries[0].fields, LogFieldCorrelationID)
+	}
+
+	recorder.entries = nil
+	PrintfCorrelation("[WARN]", "cid-2", "slow down")
+	assert.Equal(t, []recordedEntry{{level: "warn", msg: "slow down", fields: Fields{LogFieldCorrelationID: "cid-2"}}}, recorder.entries)
+}
+
+func TestStdLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	l := NewStdLogger(logstd.New(out, "", 0))
+
+	l.Info("API request completed", Fields{LogFieldStatus: 200, LogFieldService: "papi"})
+	assert.Equal(t, "[INFO] API request completed service=papi status=200\n", out.String())
+}
+
+func TestLogrusLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	logrusLogger := logrus.New()
+	logrusLogger.SetOutput(out)
+	logrusLogger.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	logrusLogger.SetLevel(logrus.InfoLevel)
+	l := NewLogrusLogger(logrusLogger)
+
+	l.Debug("hidden", nil)
+	l.Warn("clock skew", Fields{LogFieldService: "papi"})
+	assert.Equal(t, "level=warning msg=\"clock skew\" service=papi\n", out.String())
+
+	assert.False(t, LogEnabled(l, LogLevelTrace))
+	assert.False(t, LogEnabled(l, LogLevelDebug))
+	assert.True(t, LogEnabled(l, LogLevelInfo))
+	assert.True(t, LogEnabled(l, LogLevelError))
+}
+
+func TestNopLogger(t *testing.T) {
+	SetLogger(NewNopLogger())
+	defer SetLogger(nil)
+	assert.Equal(t, NewNopLogger(), GetLogger())
+
+	req, _ := http.NewRequest("GET", "https://akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net/", nil)
+	PrintHttpRequest(req, false)
+	assert.Equal(t, Fields{LogFieldOperation: "GET /"}, RequestLogFields(req))
+}
+
+// levelLogger is a recordingLogger writing the entries of the levels from min
+type levelLogger struct {
+	recordingLogger
+	min Level
+}
+
+func (l *levelLogger) Enabled(level Level) bool {
+	return level >= l.min
+}
+
+func TestLogEnabled(t *testing.T) {
+	assert.True(t, LogEnabled(&recordingLogger{}, LogLevelDebug))
+	assert.True(t, LogEnabled(NewStdLogger(nil), LogLevelDebug))
+	assert.False(t, LogEnabled(NewNopLogger(), LogLevelError))
+	assert.False(t, LogEnabled(&levelLogger{min: LogLevelInfo}, LogLevelDebug))
+}
+
+func TestPrintHttpRequest_TraceLevel(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://akab-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net/papi/v1/groups", nil)
+
+	// Dumps are not written at debug level
+	debug := &levelLogger{min: LogLevelDebug}
+	SetLogger(debug)
+	defer SetLogger(nil)
+	PrintHttpRequest(req, false)
+	assert.Empty(t, debug.entries)
+
+	trace := &levelLogger{min: LogLevelTrace}
+	SetLogger(trace)
+	PrintHttpRequest(req, false)
+	assert.NotEmpty(t, trace.entries)
+}
+
+func TestSigner_DebugLogLevel(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/papi/v1/search/find-by-value", strings.NewReader(`{"propertyName":"www.example.com"}`))
+
+	// The debug messages are not even built when debug entries are not written
+	quiet := &levelLogger{min: LogLevelInfo}
+	SetLogger(quiet)
+	defer SetLogger(nil)
+	assert.NoError(t, NewSigner(config).Sign(req))
+	assert.Empty(t, quiet.entries)
+
+	verbose := &levelLogger{min: LogLevelDebug}
+	SetLogger(verbose)
+	assert.NoError(t, NewSigner(config).Sign(req))
+	assert.NotEmpty(t, verbose.entries)
+}
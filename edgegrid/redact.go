@@ -0,0 +1,188 @@
+package edgegrid
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/textproto"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces secrets in logged requests and responses
+const RedactedValue = "[REDACTED]"
+
+// Redactor masks secrets in the HTTP dumps written by the Print* helpers
+type Redactor struct {
+	// Headers lists the names of the headers whose values are masked, matched case-insensitively
+	Headers []string
+
+	// Fields lists the JSON fields whose values are masked. A field is given as a
+	// dot separated path of object keys, array indices are not part of the path.
+	// A path matches at any depth, so "secret" masks every "secret" key, while
+	// "tsigKey.secret" only masks the "secret" keys of "tsigKey" objects.
+	Fields []string
+}
+
+// NewDefaultRedactor returns a Redactor masking the Authorization and cookie
+// headers, and the known secret fields of the Akamai APIs wrapped by this library
+func NewDefaultRedactor() *Redactor {
+	return &Redactor{
+		Headers: []string{
+			"Authorization",
+			"Cookie",
+			"Set-Cookie",
+		},
+		Fields: []string{
+			"client_token",
+			"client_secret",
+			"access_token",
+			"secret",
+			"password",
+			"privateKey",
+			"testObjectPassword",
+			"sslClientPrivateKey",
+			"defaultSslClientPrivateKey",
+		},
+	}
+}
+
+// LogRedactor is applied to every request and response dumped by the Print*
+// helpers, and to the Authorization headers logged while signing requests.
+// It is enabled by default; set it to nil to log full dumps.
+var LogRedactor = NewDefaultRedactor()
+
+// Redact masks the secrets of an HTTP request or response dump, as produced by
+// httputil.DumpRequestOut or httputil.DumpResponse
+func (r *Redactor) Redact(dump []byte) []byte {
+	if r == nil {
+		return dump
+	}
+
+	head, body := dump, []byte(nil)
+	if idx := bytes.Index(dump, []byte("\r\n\r\n")); idx >= 0 {
+		head, body = dump[:idx+4], dump[idx+4:]
+	}
+
+	out := &bytes.Buffer{}
+	out.Write(r.redactHeaders(head))
+	out.Write(r.redactBody(body))
+	return out.Bytes()
+}
+
+// authorizationFieldRegexp matches the name=value fields of an EdgeGrid
+// Authorization header
+var authorizationFieldRegexp = regexp.MustCompile(`([A-Za-z_]+)=([^;\s]*)`)
+
+// RedactAuthorization masks the name=value fields of an EdgeGrid Authorization
+// header, or of the data signed with it, whose names are listed in Fields,
+// such as client_token and access_token
+func (r *Redactor) RedactAuthorization(header string) string {
+	if r == nil {
+		return header
+	}
+	return authorizationFieldRegexp.ReplaceAllStringFunc(header, func(field string) string {
+		name := field[:strings.Index(field, "=")]
+		if r.matchField(name) {
+			return name + "=" + RedactedValue
+		}
+		return field
+	})
+}
+
+func (r *Redactor) redactHeaders(head []byte) []byte {
+	lines := strings.Split(string(head), "\r\n")
+	for i, line := range lines {
+		idx := strings.Index(line, ":")
+		if i == 0 || idx < 0 {
+			continue
+		}
+		name := textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(line[:idx]))
+		for _, header := range r.Headers {
+			if textproto.CanonicalMIMEHeaderKey(header) == name {
+				lines[i] = line[:idx+1] + " " + RedactedValue
+				break
+			}
+		}
+	}
+	return []byte(strings.Join(lines, "\r\n"))
+}
+
+// redactBody masks the body as a whole if it is JSON, otherwise each line
+// which is JSON on its own, as found in chunked bodies
+func (r *Redactor) redactBody(body []byte) []byte {
+	if len(r.Fields) == 0 || len(body) == 0 {
+		return body
+	}
+	if redacted, ok := r.redactJSON(body); ok {
+		return redacted
+	}
+
+	lines := bytes.Split(body, []byte("\n"))
+	for i, line := range lines {
+		trimmed := bytes.TrimRight(line, "\r")
+		if redacted, ok := r.redactJSON(trimmed); ok {
+			lines[i] = append(redacted, line[len(trimmed):]...)
+		}
+	}
+	return bytes.Join(lines, []byte("\n"))
+}
+
+// redactJSON masks the configured fields of a JSON document. The document is
+// only re-encoded if a field was masked.
+func (r *Redactor) redactJSON(data []byte) ([]byte, bool) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') || !json.Valid(trimmed) {
+		return nil, false
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(trimmed, &doc); err != nil {
+		return nil, false
+	}
+	if !r.redactValue(doc, "") {
+		return data, true
+	}
+
+	redacted, err := json.Marshal(doc)
+	if err != nil {
+		return nil, false
+	}
+	return redacted, true
+}
+
+func (r *Redactor) redactValue(value interface{}, path string) bool {
+	redacted := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			if r.matchField(childPath) {
+				v[key] = RedactedValue
+				redacted = true
+				continue
+			}
+			if r.redactValue(child, childPath) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if r.redactValue(child, path) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func (r *Redactor) matchField(path string) bool {
+	for _, field := range r.Fields {
+		if path == field || strings.HasSuffix(path, "."+field) {
+			return true
+		}
+	}
+	return false
+}
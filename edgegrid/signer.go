@@ -7,6 +7,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -16,24 +17,76 @@ import (
 	"unicode"
 
 	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 )
 
 const defaultSection = "DEFAULT"
 
+// Signer signs requests using the Akamai OPEN Edgegrid Signing Scheme.
+//
+// The clock and nonce source can be replaced, which makes the produced
+// Authorization header reproducible, e.g. for golden-vector tests.
+type Signer struct {
+	// offset is the learned clock offset in nanoseconds, accessed atomically.
+	// It is kept first for 64-bit alignment on 32-bit platforms.
+	offset int64
+
+	// Config holds the credentials used to sign requests
+	Config Config
+
+	// Credentials, if set, is asked for the credentials before each request
+	// is signed, and takes precedence over Config
+	Credentials CredentialProvider
+
+	// Now returns the time the request is signed at. Defaults to time.Now.
+	Now func() time.Time
+
+	// Nonce returns the nonce of the request. Defaults to a random UUID.
+	Nonce func() string
+}
+
+// NewSigner creates a new Signer using the system clock and random nonces
+func NewSigner(config Config) *Signer {
+	return &Signer{Config: config}
+}
+
 // AddRequestHeader sets the Authorization header to use Akamai Open API
 func AddRequestHeader(config Config, req *http.Request) *http.Request {
+	return NewSigner(config).AddRequestHeader(req)
+}
 
-	if EdgegridLog == nil {
-		SetupLogging()
-		if config.Debug {
-			EdgegridLog.SetLevel(logrus.DebugLevel)
-		}
+// NewProviderSigner creates a new Signer resolving its credentials from provider
+func NewProviderSigner(provider CredentialProvider) *Signer {
+	return &Signer{Credentials: provider}
+}
+
+// AddRequestHeader sets the Authorization header to use Akamai Open API
+//
+// If the credentials cannot be retrieved, the error is logged and the request
+// is returned unsigned. Use Sign() to handle the error.
+//
+// See: AddRequestHeader()
+func (s *Signer) AddRequestHeader(req *http.Request) *http.Request {
+	if err := s.Sign(req); err != nil {
+		GetLogger().Error(fmt.Sprintf("Unable to sign request: %s", err), nil)
+	}
+	return req
+}
+
+// Sign sets the Authorization header of req to use Akamai Open API
+//
+// The credentials are retrieved from s.Credentials, if set, or s.Config.
+func (s *Signer) Sign(req *http.Request) error {
+	config, err := s.config()
+	if err != nil {
+		return err
 	}
-	timestamp := makeEdgeTimeStamp()
-	EdgegridLog.Debugf("Timestamp: '%s'", timestamp)
-	nonce := createNonce()
-	EdgegridLog.Debugf("Nonce: '%s'", nonce)
+
+	setupSigningLogging(config.Debug)
+	log := newSignLog()
+	timestamp := formatEdgeTimeStamp(s.now())
+	log.debugf("Timestamp: '%s'", timestamp)
+	nonce := s.nonce()
+	log.debugf("Nonce: '%s'", nonce)
 
 	if req.Header.Get("Content-Type") == "" {
 		req.Header.Set("Content-Type", "application/json")
@@ -59,15 +112,80 @@ func AddRequestHeader(config Config, req *http.Request) *http.Request {
 		}
 	}
 
-	req.Header.Set("Authorization", createAuthHeader(config, req, timestamp, nonce))
-	return req
+	req.Header.Set("Authorization", createAuthHeader(log, config, req, timestamp, nonce))
+	return nil
+}
+
+// signLog logs the details of the signing of a request at debug level. The
+// messages are only formatted if the logger writes debug entries.
+type signLog struct {
+	logger  Logger
+	enabled bool
+}
+
+// newSignLog returns a signLog writing to GetLogger()
+func newSignLog() signLog {
+	logger := GetLogger()
+	return signLog{logger: logger, enabled: LogEnabled(logger, LogLevelDebug)}
+}
+
+func (l signLog) debugf(format string, args ...interface{}) {
+	if l.enabled {
+		l.logger.Debug(fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// debugRedacted logs authorization, an Authorization header or the data signed
+// with it, masked by LogRedactor
+func (l signLog) debugRedacted(format string, authorization string) {
+	if l.enabled {
+		l.debugf(format, LogRedactor.RedactAuthorization(authorization))
+	}
+}
+
+func (l signLog) errorf(format string, args ...interface{}) {
+	logger := l.logger
+	if logger == nil {
+		logger = GetLogger()
+	}
+	logger.Error(fmt.Sprintf(format, args...), nil)
+}
+
+func (s *Signer) config() (Config, error) {
+	if s.Credentials != nil {
+		return s.Credentials.Retrieve()
+	}
+	return s.Config, nil
+}
+
+// now returns the signing time, corrected by the learned clock offset
+func (s *Signer) now() time.Time {
+	return s.localNow().Add(s.ClockOffset())
+}
+
+func (s *Signer) localNow() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+func (s *Signer) nonce() string {
+	if s.Nonce != nil {
+		return s.Nonce()
+	}
+	return createNonce()
 }
 
 // Must be assigned the UTC time when the request is signed.
 // Format of “yyyyMMddTHH:mm:ss+0000”
 func makeEdgeTimeStamp() string {
+	return formatEdgeTimeStamp(time.Now())
+}
+
+func formatEdgeTimeStamp(t time.Time) string {
 	local := time.FixedZone("GMT", 0)
-	t := time.Now().In(local)
+	t = t.In(local)
 	return fmt.Sprintf("%d%02d%02dT%02d:%02d:%02d+0000",
 		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second())
 }
@@ -78,7 +196,7 @@ func makeEdgeTimeStamp() string {
 func createNonce() string {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
-		EdgegridLog.Errorf(errorMap[ErrUUIDGenerateFailed], err)
+		GetLogger().Error(fmt.Sprintf(errorMap[ErrUUIDGenerateFailed], err), nil)
 		return ""
 	}
 	return uuid.String()
@@ -152,71 +270,112 @@ func signingKey(config Config, timestamp string) string {
 // The size of the POST body must be less than or equal to the value specified by the service.
 // Any request that does not meet this criteria SHOULD be rejected during the signing process,
 // as the request will be rejected by EdgeGrid.
-func createContentHash(config Config, req *http.Request) string {
-	var (
-		contentHash  string
-		preparedBody string
-		bodyBytes    []byte
-	)
-	if req.Body != nil {
-		bodyBytes, _ = ioutil.ReadAll(req.Body)
-		req.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
-		preparedBody = string(bodyBytes)
-	}
+//
+// Only the first MaxBody bytes of the body are read. If the request has a GetBody
+// function, the hash is computed from a fresh copy of the body and req.Body is left
+// untouched. Otherwise req.Body is replaced by a reader replaying the consumed bytes
+// followed by the rest of the original body, so the body is never buffered as a whole.
+func createContentHash(log signLog, config Config, req *http.Request) string {
+	var contentHash string
 
-	EdgegridLog.Debugf("Body is %s", preparedBody)
-	if req.Method == "POST" && len(preparedBody) > 0 {
-		EdgegridLog.Debugf("Signing content: %s", preparedBody)
-		if len(preparedBody) > config.MaxBody {
-			EdgegridLog.Debugf("Data length %d is larger than maximum %d",
-				len(preparedBody), config.MaxBody)
+	if req.Method == "POST" && req.Body != nil && req.Body != http.NoBody {
+		prefix := readBodyPrefix(log, config, req)
+		log.debugf("Read %d bytes of the body", len(prefix))
 
-			preparedBody = preparedBody[0:config.MaxBody]
-			EdgegridLog.Debugf("Data truncated to %d for computing the hash", len(preparedBody))
+		if len(prefix) > 0 {
+			if maxBody := config.MaxBody; len(prefix) > maxBody {
+				if maxBody < 0 {
+					maxBody = 0
+				}
+				prefix = prefix[0:maxBody]
+				log.debugf("Data is larger than maximum %d, truncated for computing the hash", config.MaxBody)
+			}
+			log.debugf("Signing %d bytes of content", len(prefix))
+			contentHash = createHash(string(prefix))
 		}
-		contentHash = createHash(preparedBody)
 	}
-	EdgegridLog.Debugf("Content hash is '%s'", contentHash)
+	log.debugf("Content hash is '%s'", contentHash)
 	return contentHash
 }
 
+// readBodyPrefix reads up to MaxBody+1 bytes of the request body, the extra byte telling
+// whether the body has to be truncated for hashing.
+func readBodyPrefix(log signLog, config Config, req *http.Request) []byte {
+	limit := int64(config.MaxBody) + 1
+	if limit < 1 {
+		limit = 1
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			defer body.Close()
+			prefix, err := ioutil.ReadAll(io.LimitReader(body, limit))
+			if err != nil {
+				log.errorf("Unable to read request body: %s", err)
+			}
+			return prefix
+		}
+		log.debugf("GetBody failed, reading the body directly: %s", err)
+	}
+
+	prefix, err := ioutil.ReadAll(io.LimitReader(req.Body, limit))
+	if err != nil {
+		log.errorf("Unable to read request body: %s", err)
+	}
+	req.Body = &replayedBody{
+		Reader: io.MultiReader(bytes.NewReader(prefix), req.Body),
+		Closer: req.Body,
+	}
+	return prefix
+}
+
+// replayedBody is a request body whose beginning has already been consumed for hashing
+type replayedBody struct {
+	io.Reader
+	io.Closer
+}
+
 // The data to sign includes the information from the HTTP request that is relevant to ensuring that the request is authentic.
 // This data set comprised of the request data combined with the authorization header value (excluding the signature field,
 // but including the ; right before the signature field).
-func signingData(config Config, req *http.Request, authHeader string) string {
+func signingData(log signLog, config Config, req *http.Request, authHeader string) string {
+	return joinSigningData(log, req, canonicalizeHeaders(config, req), createContentHash(log, config, req), authHeader)
+}
 
+func joinSigningData(log signLog, req *http.Request, canonicalHeaders string, contentHash string, authHeader string) string {
 	dataSign := []string{
 		req.Method,
 		req.URL.Scheme,
 		req.URL.Host,
 		concatPathQuery(req.URL.EscapedPath(), req.URL.RawQuery),
-		canonicalizeHeaders(config, req),
-		createContentHash(config, req),
+		canonicalHeaders,
+		contentHash,
 		authHeader,
 	}
-	EdgegridLog.Debugf("Data to sign %s", strings.Join(dataSign, "\t"))
+	log.debugRedacted("Data to sign %s", strings.Join(dataSign, "\t"))
 	return strings.Join(dataSign, "\t")
 }
 
-func signingRequest(config Config, req *http.Request, authHeader string, timestamp string) string {
-	return createSignature(signingData(config, req, authHeader),
+func signingRequest(log signLog, config Config, req *http.Request, authHeader string, timestamp string) string {
+	return createSignature(signingData(log, config, req, authHeader),
 		signingKey(config, timestamp))
 }
 
 // The Authorization header starts with the signing algorithm moniker (name of the algorithm) used to sign the request.
 // The moniker below identifies EdgeGrid V1, hash message authentication code, SHA–256 as the hash standard.
 // This moniker is then followed by a space and an ordered list of name value pairs with each field separated by a semicolon.
-func createAuthHeader(config Config, req *http.Request, timestamp string, nonce string) string {
+func createAuthHeader(log signLog, config Config, req *http.Request, timestamp string, nonce string) string {
 	authHeader := fmt.Sprintf("EG1-HMAC-SHA256 client_token=%s;access_token=%s;timestamp=%s;nonce=%s;",
 		config.ClientToken,
 		config.AccessToken,
 		timestamp,
 		nonce,
 	)
-	EdgegridLog.Debugf("Unsigned authorization header: '%s'", authHeader)
+	log.debugRedacted("Unsigned authorization header: '%s'", authHeader)
 
-	signedAuthHeader := fmt.Sprintf("%ssignature=%s", authHeader, signingRequest(config, req, authHeader, timestamp))
+	signedAuthHeader := fmt.Sprintf("%ssignature=%s", authHeader, signingRequest(log, config, req, authHeader, timestamp))
 
-	EdgegridLog.Debugf("Signed authorization header: '%s'", signedAuthHeader)
+	log.debugRedacted("Signed authorization header: '%s'", signedAuthHeader)
 	return signedAuthHeader
 }
@@ -3,11 +3,14 @@ package edgegrid
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/jsonhooks-v1"
 	"github.com/stretchr/testify/assert"
@@ -89,7 +92,7 @@ func TestCreateAuthHeader(t *testing.T) {
 			}
 		}
 		SetupLogging()
-		actual := createAuthHeader(config, req, timestamp, nonce)
+		actual := createAuthHeader(signLog{}, config, req, timestamp, nonce)
 		if assert.Equal(t, edge.ExpectedAuthorization, actual, fmt.Sprintf("Fail: %s", edge.Name)) {
 			t.Logf("Pass: %s\n", edge.Name)
 			t.Logf("Expected: %s - Actual %s", edge.ExpectedAuthorization, actual)
@@ -97,3 +100,97 @@ func TestCreateAuthHeader(t *testing.T) {
 
 	}
 }
+
+func TestSigner_GoldenVectors(t *testing.T) {
+	var edgegrid JSONTests
+	byt, err := ioutil.ReadFile(testFile)
+	if err != nil {
+		t.Fatalf("Test file not found, err %s", err)
+	}
+	url, err := url.Parse(config.Host)
+	if err != nil {
+		t.Fatalf("URL is not parsable, err %s", err)
+	}
+	err = jsonhooks.Unmarshal(byt, &edgegrid)
+	if err != nil {
+		t.Fatalf("JSON is not parsable, err %s", err)
+	}
+	signedAt, err := time.Parse(edgeTimeStampLayout, timestamp)
+	if err != nil {
+		t.Fatalf("Timestamp is not parsable, err %s", err)
+	}
+
+	signer := &Signer{
+		Config: config,
+		Now:    func() time.Time { return signedAt },
+		Nonce:  func() string { return nonce },
+	}
+	verifier := &Verifier{
+		Config: config,
+		Now:    func() time.Time { return signedAt },
+	}
+	for _, edge := range edgegrid.Tests {
+		url.Path = edge.Request.Path
+		req, _ := http.NewRequest(
+			edge.Request.Method,
+			url.String(),
+			bytes.NewBuffer([]byte(edge.Request.Data)),
+		)
+		for _, header := range edge.Request.Headers {
+			for k, v := range header {
+				req.Header.Set(k, v)
+			}
+		}
+		req = signer.AddRequestHeader(req)
+		assert.Equal(t, edge.ExpectedAuthorization, req.Header.Get("Authorization"), fmt.Sprintf("Fail: %s", edge.Name))
+		assert.NoError(t, verifier.Verify(req), fmt.Sprintf("Fail: %s", edge.Name))
+	}
+}
+
+// onceReader is a request body which can only be read once and has no GetBody
+type onceReader struct {
+	io.Reader
+	read int
+}
+
+func (r *onceReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += n
+	return n, err
+}
+
+func TestCreateContentHash_Streaming(t *testing.T) {
+	SetupLogging()
+	data := strings.Repeat("d", config.MaxBody*4)
+	expected := createHash(data[:config.MaxBody])
+
+	t.Run("GetBody", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "https://example.com/", strings.NewReader(data))
+		original := req.Body
+		assert.Equal(t, expected, createContentHash(signLog{}, config, req))
+		assert.Equal(t, original, req.Body, "body must not be replaced when GetBody is available")
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, data, string(body))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		reader := &onceReader{Reader: strings.NewReader(data)}
+		req, _ := http.NewRequest("POST", "https://example.com/", reader)
+		assert.Nil(t, req.GetBody)
+		assert.Equal(t, expected, createContentHash(signLog{}, config, req))
+		assert.Equal(t, config.MaxBody+1, reader.read, "only the first MaxBody bytes may be consumed")
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, data, string(body))
+	})
+
+	t.Run("not POST", func(t *testing.T) {
+		reader := &onceReader{Reader: strings.NewReader(data)}
+		req, _ := http.NewRequest("PUT", "https://example.com/", reader)
+		assert.Equal(t, "", createContentHash(signLog{}, config, req))
+		assert.Equal(t, 0, reader.read)
+	})
+}
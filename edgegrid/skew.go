@@ -0,0 +1,49 @@
+package edgegrid
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// minClockSkew is the smallest difference to the server clock treated as clock
+// skew; anything below is attributed to latency and the 1s resolution of Date
+const minClockSkew = 5 * time.Second
+
+// ClockOffset returns the difference between the Akamai server clock and the
+// local clock, as learned by AdjustClock. It is added to the signing timestamps.
+func (s *Signer) ClockOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&s.offset))
+}
+
+// SetClockOffset sets the difference between the Akamai server clock and the local clock
+func (s *Signer) SetClockOffset(offset time.Duration) {
+	atomic.StoreInt64(&s.offset, int64(offset))
+}
+
+// AdjustClock inspects the response to a request signed by s. A 401 Unauthorized
+// response whose Date header is too far from the signing clock is treated as
+// caused by a skewed local clock: the offset to the server clock is stored,
+// so that subsequent signatures use the server time, and true is returned.
+//
+// See: Transport.RoundTrip()
+func (s *Signer) AdjustClock(res *http.Response) bool {
+	if res == nil || res.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	serverTime, err := http.ParseTime(res.Header.Get("Date"))
+	if err != nil {
+		return false
+	}
+
+	if skew := serverTime.Sub(s.now()); skew > -minClockSkew && skew < minClockSkew {
+		return false
+	}
+
+	offset := serverTime.Sub(s.localNow())
+	s.SetClockOffset(offset)
+
+	GetLogger().Warn(fmt.Sprintf("Local clock differs from the server clock by %s, adjusting signing timestamps", offset), nil)
+	return true
+}
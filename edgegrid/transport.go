@@ -0,0 +1,116 @@
+package edgegrid
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// Transport is an http.RoundTripper that signs every outgoing request with
+// the Akamai OPEN Edgegrid Authorization header before handing it to the
+// Base transport. Requests issued by an http.Client while following
+// redirects go through RoundTrip as well, so they are signed too.
+//
+// When a request is rejected because of a skewed local clock, the clock
+// offset is learned by the Signer and the request is signed and sent once more.
+//
+//	config, _ := edgegrid.Init("~/.edgerc", "default")
+//	httpClient := &http.Client{Transport: edgegrid.NewTransport(config, nil)}
+type Transport struct {
+	// Config holds the credentials used to sign requests
+	Config Config
+
+	// Signer, if set, signs the requests instead of a default Signer for Config
+	Signer *Signer
+
+	// Base is the underlying transport used to send the signed requests.
+	// If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	once          sync.Once
+	defaultSigner *Signer
+}
+
+// NewTransport creates a new Transport signing requests with config and
+// sending them through base. A nil base means http.DefaultTransport.
+func NewTransport(config Config, base http.RoundTripper) *Transport {
+	return &Transport{
+		Config: config,
+		Signer: NewSigner(config),
+		Base:   base,
+	}
+}
+
+// RoundTrip signs a copy of req and sends it using the Base transport.
+//
+// As required by http.RoundTripper, req itself is not modified.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	signer := t.signer()
+
+	signed := req.Clone(req.Context())
+	if err := signer.Sign(signed); err != nil {
+		closeBody(signed)
+		return nil, err
+	}
+
+	res, err := t.base().RoundTrip(signed)
+	if err != nil || !signer.AdjustClock(res) {
+		return res, err
+	}
+
+	retry, ok := rewindRequest(req)
+	if !ok {
+		return res, nil
+	}
+	io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+
+	if err := signer.Sign(retry); err != nil {
+		closeBody(retry)
+		return nil, err
+	}
+	return t.base().RoundTrip(retry)
+}
+
+// closeBody closes the body of a request which is not sent, as a RoundTripper
+// must even on errors
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// rewindRequest returns a copy of req with a fresh body, if the body can be replayed
+func rewindRequest(req *http.Request) (*http.Request, bool) {
+	retry := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return retry, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	retry.Body = body
+	return retry, true
+}
+
+func (t *Transport) signer() *Signer {
+	if t.Signer != nil {
+		return t.Signer
+	}
+	t.once.Do(func() {
+		t.defaultSigner = NewSigner(t.Config)
+	})
+	return t.defaultSigner
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
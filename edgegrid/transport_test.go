@@ -0,0 +1,156 @@
+package edgegrid
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/target", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	SetupLogging()
+	httpClient := &http.Client{Transport: NewTransport(config, nil)}
+
+	req, err := http.NewRequest("GET", server.URL+"/redirect", nil)
+	assert.NoError(t, err)
+
+	res, err := httpClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	if assert.Len(t, authHeaders, 2) {
+		for _, auth := range authHeaders {
+			assert.True(t, strings.HasPrefix(auth, "EG1-HMAC-SHA256 client_token="+config.ClientToken+";"))
+			assert.Contains(t, auth, ";signature=")
+		}
+		assert.NotEqual(t, authHeaders[0], authHeaders[1])
+	}
+	assert.Empty(t, req.Header.Get("Authorization"), "original request must not be modified")
+}
+
+func TestTransport_ClockSkew(t *testing.T) {
+	serverNow := func() time.Time { return time.Now().Add(time.Hour) }
+	verifier := &Verifier{Config: config, Now: serverNow}
+
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Date", serverNow().UTC().Format(http.TimeFormat))
+		if err := verifier.Verify(r); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	transport := NewTransport(config, nil)
+	httpClient := &http.Client{Transport: transport}
+
+	res, err := httpClient.Post(server.URL+"/papi/v1/search/find-by-value", "text/plain", strings.NewReader("payload"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	body, _ := ioutil.ReadAll(res.Body)
+	assert.Equal(t, "payload", string(body))
+	assert.Equal(t, 2, attempts)
+	assert.InDelta(t, float64(time.Hour), float64(transport.Signer.ClockOffset()), float64(2*time.Second))
+
+	res, err = httpClient.Get(server.URL + "/papi/v1/groups")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 3, attempts, "the learned offset must be reused")
+}
+
+type failingProvider struct {
+	err error
+}
+
+func (p *failingProvider) Retrieve() (Config, error) {
+	return Config{}, p.err
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestTransport_SignErrorClosesBody(t *testing.T) {
+	boom := errors.New("no credentials")
+	transport := &Transport{Signer: NewProviderSigner(&failingProvider{boom})}
+
+	body := &closeRecorder{Reader: strings.NewReader("payload")}
+	req, err := http.NewRequest("POST", "https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/upload", body)
+	assert.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.Equal(t, boom, err)
+	assert.True(t, body.closed)
+}
+
+func TestSigner_AdjustClock(t *testing.T) {
+	signer := NewSigner(config)
+	res := &http.Response{StatusCode: http.StatusUnauthorized, Header: http.Header{}}
+
+	res.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	assert.False(t, signer.AdjustClock(res), "no skew")
+
+	res.Header.Set("Date", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, signer.AdjustClock(res))
+	assert.InDelta(t, float64(-time.Minute), float64(signer.ClockOffset()), float64(2*time.Second))
+	assert.False(t, signer.AdjustClock(res), "offset already learned")
+
+	res.StatusCode = http.StatusForbidden
+	res.Header.Set("Date", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.False(t, signer.AdjustClock(res), "not a 401")
+}
+
+// Run with the race detector, go test -race
+func TestTransport_ConcurrentFirstRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Logging is set up by the first requests signed, made concurrently
+	edgegridLog := EdgegridLog
+	EdgegridLog = nil
+	defer func() { EdgegridLog = edgegridLog }()
+
+	httpClient := &http.Client{Transport: NewTransport(config, nil)}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := httpClient.Get(server.URL + "/papi/v1/groups")
+			if assert.NoError(t, err) {
+				res.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,276 @@
+package edgegrid
+
+import (
+	"bytes"
+	"container/heap"
+	"crypto/hmac"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	authScheme = "EG1-HMAC-SHA256"
+
+	// DefaultMaxSkew is the maximum allowed difference between the signing
+	// timestamp of a request and the local clock, used when Verifier.MaxSkew is zero
+	DefaultMaxSkew = 30 * time.Second
+
+	edgeTimeStampLayout = "20060102T15:04:05-0700"
+)
+
+// VerifyReason describes why a request failed the signature verification
+type VerifyReason string
+
+const (
+	// VerifyReasonMalformedHeader the Authorization header is missing or cannot be parsed
+	VerifyReasonMalformedHeader VerifyReason = "malformed authorization header"
+	// VerifyReasonBadToken the client_token or access_token do not match the configured credentials
+	VerifyReasonBadToken VerifyReason = "invalid client or access token"
+	// VerifyReasonTimestampSkew the signing timestamp is too far from the local clock
+	VerifyReasonTimestampSkew VerifyReason = "timestamp outside of allowed skew"
+	// VerifyReasonReplayedNonce the nonce has already been used by an earlier request
+	VerifyReasonReplayedNonce VerifyReason = "nonce already used"
+	// VerifyReasonBodyHash the signature was computed over a different request body
+	VerifyReasonBodyHash VerifyReason = "content hash mismatch"
+	// VerifyReasonHeaders the signature was computed over a different set of headers
+	VerifyReasonHeaders VerifyReason = "signed headers mismatch"
+	// VerifyReasonSignature the signature does not match for any other reason
+	VerifyReasonSignature VerifyReason = "signature mismatch"
+)
+
+// VerifyError is returned when a request fails the signature verification
+type VerifyError struct {
+	Reason VerifyReason
+	Detail string
+}
+
+func (e *VerifyError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("Edgegrid verification failed: %s", e.Reason)
+	}
+	return fmt.Sprintf("Edgegrid verification failed: %s: %s", e.Reason, e.Detail)
+}
+
+// NonceCache remembers nonces of already verified requests, to detect replays
+type NonceCache interface {
+	// Seen records nonce as used until expiry and reports whether it
+	// had already been recorded before
+	Seen(nonce string, expiry time.Time) bool
+}
+
+// MemoryNonceCache is an in-memory NonceCache safe for concurrent use
+type MemoryNonceCache struct {
+	mu       sync.Mutex
+	nonces   map[string]time.Time
+	expiries nonceExpiries
+}
+
+// NewMemoryNonceCache creates a new, empty MemoryNonceCache
+func NewMemoryNonceCache() *MemoryNonceCache {
+	return &MemoryNonceCache{nonces: make(map[string]time.Time)}
+}
+
+// Seen implements NonceCache. The nonces expired are evicted first, from
+// the oldest, so a call only walks the nonces it evicts.
+func (c *MemoryNonceCache) Seen(nonce string, expiry time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for len(c.expiries) > 0 && now.After(c.expiries[0].expiry) {
+		delete(c.nonces, heap.Pop(&c.expiries).(nonceExpiry).nonce)
+	}
+
+	if _, ok := c.nonces[nonce]; ok {
+		return true
+	}
+	c.nonces[nonce] = expiry
+	heap.Push(&c.expiries, nonceExpiry{nonce: nonce, expiry: expiry})
+	return false
+}
+
+// nonceExpiry is a nonce recorded by a MemoryNonceCache, until expiry
+type nonceExpiry struct {
+	nonce  string
+	expiry time.Time
+}
+
+// nonceExpiries is a heap of nonces, the first to expire on top
+type nonceExpiries []nonceExpiry
+
+func (h nonceExpiries) Len() int            { return len(h) }
+func (h nonceExpiries) Less(i, j int) bool  { return h[i].expiry.Before(h[j].expiry) }
+func (h nonceExpiries) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *nonceExpiries) Push(x interface{}) { *h = append(*h, x.(nonceExpiry)) }
+
+func (h *nonceExpiries) Pop() interface{} {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// Verifier checks Akamai OPEN Edgegrid signatures of incoming requests, the
+// way the Akamai APIs do. It is meant for local stand-ins of Akamai services.
+type Verifier struct {
+	// Config holds the credentials requests are expected to be signed with
+	Config Config
+
+	// MaxSkew is the maximum allowed difference between the request timestamp
+	// and the local clock. Defaults to DefaultMaxSkew.
+	MaxSkew time.Duration
+
+	// Nonces, if set, is used to reject replayed requests
+	Nonces NonceCache
+
+	// Now returns the local time requests are checked against. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Verify checks the Authorization header of req against config
+//
+// See: Verifier.Verify()
+func Verify(config Config, req *http.Request) error {
+	v := &Verifier{Config: config}
+	return v.Verify(req)
+}
+
+// Verify parses the EG1-HMAC-SHA256 Authorization header of req and
+// recomputes its signature. A *VerifyError is returned if the request
+// is not correctly signed.
+//
+// The request body is read and restored, so it can still be consumed afterwards.
+// Requests received by an http.Server do not carry the scheme and host in
+// req.URL; those are taken from req.TLS and req.Host instead.
+func (v *Verifier) Verify(req *http.Request) error {
+	fields, unsigned, err := parseAuthHeader(req.Header.Get("Authorization"))
+	if err != nil {
+		return err
+	}
+
+	if fields["client_token"] != v.Config.ClientToken || fields["access_token"] != v.Config.AccessToken {
+		return &VerifyError{Reason: VerifyReasonBadToken}
+	}
+
+	timestamp := fields["timestamp"]
+	signedAt, err := time.Parse(edgeTimeStampLayout, timestamp)
+	if err != nil {
+		return &VerifyError{Reason: VerifyReasonMalformedHeader, Detail: fmt.Sprintf("invalid timestamp %q", timestamp)}
+	}
+	maxSkew := v.MaxSkew
+	if maxSkew == 0 {
+		maxSkew = DefaultMaxSkew
+	}
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+	if skew := now().Sub(signedAt); skew > maxSkew || skew < -maxSkew {
+		return &VerifyError{Reason: VerifyReasonTimestampSkew, Detail: fmt.Sprintf("skew of %s", skew.Round(time.Second))}
+	}
+
+	signed := serverRequest(req)
+	var body []byte
+	if signed.Body != nil {
+		body, err = ioutil.ReadAll(signed.Body)
+		if err != nil {
+			return err
+		}
+		signed.Body = ioutil.NopCloser(bytes.NewReader(body))
+		signed.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+	defer func() {
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+	}()
+
+	log := newSignLog()
+	key := signingKey(v.Config, timestamp)
+	headers := canonicalizeHeaders(v.Config, signed)
+	contentHash := createContentHash(log, v.Config, signed)
+	matches := func(headers, contentHash string) bool {
+		expected := createSignature(joinSigningData(log, signed, headers, contentHash, unsigned), key)
+		return hmac.Equal([]byte(expected), []byte(fields["signature"]))
+	}
+
+	if !matches(headers, contentHash) {
+		return &VerifyError{Reason: diagnoseMismatch(matches, headers, contentHash, body)}
+	}
+
+	if v.Nonces != nil && v.Nonces.Seen(fields["nonce"], signedAt.Add(maxSkew)) {
+		return &VerifyError{Reason: VerifyReasonReplayedNonce, Detail: fields["nonce"]}
+	}
+
+	return nil
+}
+
+// diagnoseMismatch tries the most common signing mistakes to tell apart a
+// wrong body hash or a wrong set of signed headers from a bad signature.
+func diagnoseMismatch(matches func(headers, contentHash string) bool, headers, contentHash string, body []byte) VerifyReason {
+	for _, altHash := range []string{"", createHash(string(body))} {
+		if altHash != contentHash && matches(headers, altHash) {
+			return VerifyReasonBodyHash
+		}
+	}
+	if headers != "" && matches("", contentHash) {
+		return VerifyReasonHeaders
+	}
+	return VerifyReasonSignature
+}
+
+// parseAuthHeader splits an EG1-HMAC-SHA256 Authorization header into its
+// fields. It also returns the unsigned part of the header, up to and
+// including the ";" before the signature, which is part of the signed data.
+func parseAuthHeader(header string) (map[string]string, string, error) {
+	if !strings.HasPrefix(header, authScheme+" ") {
+		return nil, "", &VerifyError{Reason: VerifyReasonMalformedHeader, Detail: "missing " + authScheme + " scheme"}
+	}
+
+	sigIdx := strings.LastIndex(header, ";signature=")
+	if sigIdx < 0 {
+		return nil, "", &VerifyError{Reason: VerifyReasonMalformedHeader, Detail: "missing signature"}
+	}
+
+	fields := make(map[string]string)
+	for _, pair := range strings.Split(strings.TrimPrefix(header, authScheme+" "), ";") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return nil, "", &VerifyError{Reason: VerifyReasonMalformedHeader, Detail: fmt.Sprintf("invalid field %q", pair)}
+		}
+		fields[kv[0]] = kv[1]
+	}
+
+	for _, required := range []string{"client_token", "access_token", "timestamp", "nonce", "signature"} {
+		if fields[required] == "" {
+			return nil, "", &VerifyError{Reason: VerifyReasonMalformedHeader, Detail: "missing " + required}
+		}
+	}
+
+	return fields, header[:sigIdx+1], nil
+}
+
+// serverRequest returns a shallow copy of req with the URL scheme and host
+// filled in, as they were when the client signed the request.
+func serverRequest(req *http.Request) *http.Request {
+	r := *req
+	u := *req.URL
+	if u.Scheme == "" {
+		u.Scheme = "http"
+		if req.TLS != nil {
+			u.Scheme = "https"
+		}
+	}
+	if u.Host == "" {
+		u.Host = req.Host
+	}
+	r.URL = &u
+	return &r
+}
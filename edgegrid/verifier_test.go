@@ -0,0 +1,119 @@
+package edgegrid
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSignedRequest(t *testing.T, config Config, method, body string) *http.Request {
+	req, err := http.NewRequest(method, "https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net/papi/v1/groups?a=b", bytes.NewBufferString(body))
+	assert.NoError(t, err)
+	req.Header.Set("X-Test1", "value")
+	return AddRequestHeader(config, req)
+}
+
+func assertVerifyReason(t *testing.T, err error, reason VerifyReason) {
+	var verifyErr *VerifyError
+	if assert.True(t, errors.As(err, &verifyErr), "expected *VerifyError, got %v", err) {
+		assert.Equal(t, reason, verifyErr.Reason)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	req := newSignedRequest(t, config, "POST", "datadatadata")
+	assert.NoError(t, Verify(config, req))
+
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "datadatadata", string(body), "body must be restored")
+}
+
+func TestVerify_Server(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := Verify(config, r); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: NewTransport(config, nil)}
+	res, err := httpClient.Post(server.URL+"/ccu/v3/invalidate/url", "application/json", bytes.NewBufferString(`{"objects":[]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res, err = http.Get(server.URL + "/ccu/v3/invalidate/url")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestVerify_Malformed(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://example.com/", nil)
+	assertVerifyReason(t, Verify(config, req), VerifyReasonMalformedHeader)
+
+	req.Header.Set("Authorization", "EG1-HMAC-SHA256 client_token=a;access_token=b")
+	assertVerifyReason(t, Verify(config, req), VerifyReasonMalformedHeader)
+}
+
+func TestVerify_BadToken(t *testing.T) {
+	other := config
+	other.AccessToken = "akab-access-token-yyy-yyyyyyyyyyyyyyyy"
+	req := newSignedRequest(t, other, "GET", "")
+	assertVerifyReason(t, Verify(config, req), VerifyReasonBadToken)
+}
+
+func TestVerify_TimestampSkew(t *testing.T) {
+	req := newSignedRequest(t, config, "GET", "")
+	req.Header.Set("Authorization", createAuthHeader(signLog{}, config, req, timestamp, nonce))
+	assertVerifyReason(t, Verify(config, req), VerifyReasonTimestampSkew)
+}
+
+func TestVerify_BodyHash(t *testing.T) {
+	req := newSignedRequest(t, config, "POST", "")
+	req.Body = ioutil.NopCloser(bytes.NewBufferString("tampered"))
+	assertVerifyReason(t, Verify(config, req), VerifyReasonBodyHash)
+}
+
+func TestVerify_Headers(t *testing.T) {
+	noHeaders := config
+	noHeaders.HeaderToSign = nil
+	req := newSignedRequest(t, noHeaders, "GET", "")
+	assertVerifyReason(t, Verify(config, req), VerifyReasonHeaders)
+}
+
+func TestVerify_Signature(t *testing.T) {
+	other := config
+	other.ClientSecret = "yyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyy="
+	req := newSignedRequest(t, other, "GET", "")
+	assertVerifyReason(t, Verify(config, req), VerifyReasonSignature)
+}
+
+func TestVerify_ReplayedNonce(t *testing.T) {
+	verifier := &Verifier{Config: config, Nonces: NewMemoryNonceCache()}
+	req := newSignedRequest(t, config, "GET", "")
+	assert.NoError(t, verifier.Verify(req))
+	assertVerifyReason(t, verifier.Verify(req), VerifyReasonReplayedNonce)
+}
+
+func TestMemoryNonceCache_Expiry(t *testing.T) {
+	cache := NewMemoryNonceCache()
+	now := time.Now()
+	assert.False(t, cache.Seen("late", now.Add(time.Hour)))
+	assert.False(t, cache.Seen("expired", now.Add(-time.Minute)))
+	assert.False(t, cache.Seen("early", now.Add(time.Minute)))
+
+	// The expired nonce is evicted, and may be used again
+	assert.True(t, cache.Seen("late", now.Add(time.Hour)))
+	assert.Len(t, cache.nonces, 2)
+	assert.False(t, cache.Seen("expired", now.Add(time.Minute)))
+	assert.True(t, cache.Seen("early", now.Add(time.Minute)))
+	assert.Len(t, cache.expiries, 3)
+}
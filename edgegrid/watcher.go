@@ -0,0 +1,156 @@
+package edgegrid
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultWatchInterval is how often a Watcher checks its source, used when the given interval is zero
+	DefaultWatchInterval = 30 * time.Second
+
+	watcherEventBuffer = 16
+)
+
+// ReloadEvent reports the outcome of a Watcher reload
+type ReloadEvent struct {
+	// Config holds the credentials in use after the reload
+	Config Config
+	// Err is the reason the reload failed, nil on success
+	Err error
+	// Time is when the reload happened
+	Time time.Time
+}
+
+// Watcher periodically re-reads credentials from a source, e.g. an .edgerc
+// file or the environment, and atomically swaps them when they change.
+// If the source cannot be read, the previous credentials are kept.
+//
+// Watcher is a CredentialProvider, so signers using it pick up rotated
+// credentials without a restart:
+//
+//	watcher, err := edgegrid.WatchEdgeRc("~/.edgerc", "default", time.Minute)
+//	if err != nil {
+//		return err
+//	}
+//	defer watcher.Stop()
+//	httpClient := &http.Client{
+//		Transport: &edgegrid.Transport{Signer: edgegrid.NewProviderSigner(watcher)},
+//	}
+type Watcher struct {
+	source  CredentialProvider
+	current atomic.Value
+	events  chan ReloadEvent
+
+	mu       sync.Mutex
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewWatcher loads the credentials from source and starts checking it for
+// changes every interval. An error is returned if the initial load fails.
+func NewWatcher(source CredentialProvider, interval time.Duration) (*Watcher, error) {
+	config, err := source.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	w := &Watcher{
+		source: source,
+		events: make(chan ReloadEvent, watcherEventBuffer),
+		stop:   make(chan struct{}),
+	}
+	w.current.Store(config)
+
+	go w.run(interval)
+	return w, nil
+}
+
+// WatchEdgeRc watches a section of an .edgerc file
+//
+// See: InitEdgeRc()
+func WatchEdgeRc(filepath string, section string, interval time.Duration) (*Watcher, error) {
+	return NewWatcher(&EdgeRcProvider{Path: filepath, Section: section}, interval)
+}
+
+// WatchEnv watches the AKAMAI_* environment variables of a section
+//
+// See: InitEnv()
+func WatchEnv(section string, interval time.Duration) (*Watcher, error) {
+	return NewWatcher(&EnvProvider{Section: section}, interval)
+}
+
+// Retrieve implements CredentialProvider, returning the current credentials
+func (w *Watcher) Retrieve() (Config, error) {
+	return w.Config(), nil
+}
+
+// Config returns the current credentials
+func (w *Watcher) Config() Config {
+	return w.current.Load().(Config)
+}
+
+// Events returns the channel on which reload events are sent. An event is
+// sent when changed credentials have been loaded, or when the source could
+// not be read. Events are dropped when the channel buffer is full.
+func (w *Watcher) Events() <-chan ReloadEvent {
+	return w.events
+}
+
+// Reload checks the source immediately. Changed credentials are swapped in
+// and nil is returned; on error the current credentials are kept.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	config, err := w.source.Retrieve()
+	if err != nil {
+		GetLogger().Warn(fmt.Sprintf("Credentials reload failed, keeping the current credentials: %s", err), nil)
+		w.emit(ReloadEvent{Config: w.Config(), Err: err, Time: time.Now()})
+		return err
+	}
+
+	if reflect.DeepEqual(config, w.Config()) {
+		return nil
+	}
+	w.current.Store(config)
+
+	GetLogger().Info("Credentials reloaded", nil)
+	w.emit(ReloadEvent{Config: config, Time: time.Now()})
+	return nil
+}
+
+// Stop stops watching the source. The current credentials remain available.
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+}
+
+func (w *Watcher) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.Reload()
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+func (w *Watcher) emit(event ReloadEvent) {
+	select {
+	case w.events <- event:
+	default:
+	}
+}
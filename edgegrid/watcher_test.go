@@ -0,0 +1,66 @@
+package edgegrid
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edgegrid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	original, err := ioutil.ReadFile("../testdata/sample_edgerc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "edgerc")
+	if err := ioutil.WriteFile(path, original, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := WatchEdgeRc(path, "test", 10*time.Millisecond)
+	assert.NoError(t, err)
+	defer watcher.Stop()
+	assert.Equal(t, "test-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx", watcher.Config().AccessToken)
+
+	rotated := strings.Replace(string(original), "access_token = test-", "access_token = rotated-", 1)
+	if err := ioutil.WriteFile(path, []byte(rotated), 0600); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-watcher.Events():
+		assert.NoError(t, event.Err)
+		assert.Equal(t, "rotated-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx", event.Config.AccessToken)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reload event")
+	}
+	c, err := watcher.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx", c.AccessToken)
+
+	if err := ioutil.WriteFile(path, []byte("[test\nbroken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-watcher.Events():
+		assert.Error(t, event.Err)
+		assert.Equal(t, "rotated-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx", event.Config.AccessToken)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reload failure event")
+	}
+	assert.Equal(t, "rotated-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx", watcher.Config().AccessToken, "credentials must be kept on errors")
+}
+
+func TestWatcher_InitialLoadFails(t *testing.T) {
+	_, err := WatchEdgeRc("edgerc_not_found", "", time.Second)
+	assert.Error(t, err)
+}
@@ -0,0 +1,486 @@
+package edgegridtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type dnsState struct {
+	zones map[string]*dnsZone
+}
+
+type dnsZone struct {
+	Zone                  string      `json:"zone"`
+	Type                  string      `json:"type"`
+	Masters               []string    `json:"masters,omitempty"`
+	Comment               string      `json:"comment,omitempty"`
+	SignAndServe          bool        `json:"signAndServe"`
+	SignAndServeAlgorithm string      `json:"signAndServeAlgorithm,omitempty"`
+	TsigKey               interface{} `json:"tsigKey,omitempty"`
+	Target                string      `json:"target,omitempty"`
+	EndCustomerID         string      `json:"endCustomerId,omitempty"`
+	ContractID            string      `json:"contractId"`
+	ActivationState       string      `json:"activationState"`
+	LastActivationDate    string      `json:"lastActivationDate,omitempty"`
+	LastModifiedBy        string      `json:"lastModifiedBy"`
+	LastModifiedDate      string      `json:"lastModifiedDate"`
+	VersionID             string      `json:"versionId"`
+
+	recordsets map[string]*dnsRecordset
+	changelist *dnsChangelist
+}
+
+type dnsRecordset struct {
+	Name  string   `json:"name"`
+	Type  string   `json:"type"`
+	TTL   int      `json:"ttl"`
+	Rdata []string `json:"rdata"`
+}
+
+type dnsChangelist struct {
+	Zone             string `json:"zone"`
+	ChangeTag        string `json:"changeTag"`
+	ZoneVersionID    string `json:"zoneVersionId"`
+	LastModifiedDate string `json:"lastModifiedDate"`
+	Stale            bool   `json:"stale"`
+
+	recordsets map[string]*dnsRecordset
+}
+
+func newDNSState() dnsState {
+	return dnsState{zones: make(map[string]*dnsZone)}
+}
+
+// serveDNS serves /config-dns/v2/{path}
+func (s *Server) serveDNS(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 1 && path[0] == "zones":
+		s.serveDNSZones(w, r)
+	case len(path) >= 2 && path[0] == "zones":
+		zone, ok := s.dns.zones[path[1]]
+		if !ok {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Zone %s not found", path[1]))
+			return
+		}
+		s.serveDNSZone(w, r, zone, path[2:])
+	case len(path) >= 1 && path[0] == "changelists":
+		s.serveDNSChangelists(w, r, path[1:])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) serveDNSZones(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodGet:
+		zones := []*dnsZone{}
+		for _, zone := range s.sortedZones() {
+			if (query.Get("search") == "" || strings.Contains(zone.Zone, query.Get("search"))) &&
+				listed(query.Get("types"), zone.Type) && listed(query.Get("contractIds"), zone.ContractID) {
+				zones = append(zones, zone)
+			}
+		}
+		page, pageSize, first, last := paginate(query, len(zones))
+		contractIDs := []string{}
+		if query.Get("contractIds") != "" {
+			contractIDs = strings.Split(query.Get("contractIds"), ",")
+		}
+		respond(w, r, http.StatusOK, map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"contractIds":   contractIDs,
+				"page":          page,
+				"pageSize":      pageSize,
+				"showAll":       query.Get("showAll") == "true",
+				"totalElements": len(zones),
+			},
+			"zones": zones[first:last],
+		})
+	case http.MethodPost:
+		zone := &dnsZone{}
+		if !decode(w, r, zone) {
+			return
+		}
+		zone.Type = strings.ToUpper(zone.Type)
+		if query.Get("contractId") != "" {
+			zone.ContractID = query.Get("contractId")
+		}
+		switch {
+		case zone.Zone == "":
+			problem(w, http.StatusBadRequest, "Bad Request", "zone is required")
+			return
+		case zone.ContractID == "":
+			problem(w, http.StatusBadRequest, "Bad Request", "contractId is required")
+			return
+		case zone.Type != "PRIMARY" && zone.Type != "SECONDARY" && zone.Type != "ALIAS":
+			problem(w, http.StatusBadRequest, "Bad Request", "type must be PRIMARY, SECONDARY or ALIAS")
+			return
+		case zone.Type == "SECONDARY" && len(zone.Masters) == 0:
+			problem(w, http.StatusBadRequest, "Bad Request", "masters are required for a SECONDARY zone")
+			return
+		case zone.Type == "ALIAS" && zone.Target == "":
+			problem(w, http.StatusBadRequest, "Bad Request", "target is required for an ALIAS zone")
+			return
+		}
+		if _, ok := s.dns.zones[zone.Zone]; ok {
+			problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Zone %s already exists", zone.Zone))
+			return
+		}
+
+		zone.ActivationState = "NEW"
+		if zone.Type != "PRIMARY" {
+			zone.ActivationState = "PENDING"
+		}
+		zone.recordsets = make(map[string]*dnsRecordset)
+		zone.touch()
+		s.dns.zones[zone.Zone] = zone
+		respond(w, r, http.StatusCreated, zone)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveDNSZone(w http.ResponseWriter, r *http.Request, zone *dnsZone, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			respond(w, r, http.StatusOK, zone)
+		case http.MethodPut:
+			if !precondition(w, r, zone) {
+				return
+			}
+			update := &dnsZone{}
+			if !decode(w, r, update) {
+				return
+			}
+			if update.Zone != zone.Zone || (update.Type != "" && strings.ToUpper(update.Type) != zone.Type) {
+				problem(w, http.StatusBadRequest, "Bad Request", "The zone name and type cannot be changed")
+				return
+			}
+			zone.Masters = update.Masters
+			zone.Comment = update.Comment
+			zone.SignAndServe = update.SignAndServe
+			zone.SignAndServeAlgorithm = update.SignAndServeAlgorithm
+			zone.TsigKey = update.TsigKey
+			zone.Target = update.Target
+			zone.EndCustomerID = update.EndCustomerID
+			zone.touch()
+			respond(w, r, http.StatusOK, zone)
+		case http.MethodDelete:
+			if !precondition(w, r, zone) {
+				return
+			}
+			delete(s.dns.zones, zone.Zone)
+			respondNoContent(w)
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	switch {
+	case len(path) == 1 && path[0] == "recordsets":
+		s.serveDNSRecordsets(w, r, zone)
+	case len(path) == 1 && path[0] == "names" && r.Method == http.MethodGet:
+		names := []string{}
+		for _, recordset := range zone.sortedRecordsets() {
+			if len(names) == 0 || names[len(names)-1] != recordset.Name {
+				names = append(names, recordset.Name)
+			}
+		}
+		respond(w, r, http.StatusOK, map[string][]string{"names": names})
+	case len(path) == 3 && path[0] == "names" && path[2] == "types" && r.Method == http.MethodGet:
+		types := []string{}
+		for _, recordset := range zone.sortedRecordsets() {
+			if recordset.Name == path[1] {
+				types = append(types, recordset.Type)
+			}
+		}
+		if len(types) == 0 {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Name %s not found in zone %s", path[1], zone.Zone))
+			return
+		}
+		respond(w, r, http.StatusOK, map[string][]string{"types": types})
+	case len(path) == 4 && path[0] == "names" && path[2] == "types":
+		s.serveDNSRecordset(w, r, zone, path[1], strings.ToUpper(path[3]))
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) serveDNSRecordsets(w http.ResponseWriter, r *http.Request, zone *dnsZone) {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodGet:
+		recordsets := []*dnsRecordset{}
+		for _, recordset := range zone.sortedRecordsets() {
+			if (query.Get("search") == "" || strings.Contains(recordset.Name, query.Get("search"))) && listed(query.Get("types"), recordset.Type) {
+				recordsets = append(recordsets, recordset)
+			}
+		}
+		page, pageSize, first, last := paginate(query, len(recordsets))
+		lastPage := (len(recordsets) + pageSize - 1) / pageSize
+		respond(w, r, http.StatusOK, map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"lastPage":      lastPage,
+				"page":          page,
+				"pageSize":      pageSize,
+				"showAll":       query.Get("showAll") == "true",
+				"totalElements": len(recordsets),
+			},
+			"recordsets": recordsets[first:last],
+		})
+	case http.MethodPost, http.MethodPut:
+		var body struct {
+			Recordsets []*dnsRecordset `json:"recordsets"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		for _, recordset := range body.Recordsets {
+			if !validRecordset(w, zone, recordset) {
+				return
+			}
+			if _, ok := zone.recordsets[recordset.key()]; ok && r.Method == http.MethodPost {
+				problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Recordset %s %s already exists", recordset.Name, recordset.Type))
+				return
+			}
+		}
+		// PUT replaces all the recordsets of the zone
+		if r.Method == http.MethodPut {
+			zone.recordsets = make(map[string]*dnsRecordset)
+		}
+		for _, recordset := range body.Recordsets {
+			zone.recordsets[recordset.key()] = recordset
+		}
+		zone.touch()
+		respondNoContent(w)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveDNSRecordset(w http.ResponseWriter, r *http.Request, zone *dnsZone, name, recordType string) {
+	key := (&dnsRecordset{Name: name, Type: recordType}).key()
+	current, exists := zone.recordsets[key]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Recordset %s %s not found", name, recordType))
+			return
+		}
+		respond(w, r, http.StatusOK, current)
+	case http.MethodPost, http.MethodPut:
+		if exists && r.Method == http.MethodPost {
+			problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Recordset %s %s already exists", name, recordType))
+			return
+		}
+		if !exists && r.Method == http.MethodPut {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Recordset %s %s not found", name, recordType))
+			return
+		}
+		if exists && !precondition(w, r, current) {
+			return
+		}
+		recordset := &dnsRecordset{}
+		if !decode(w, r, recordset) {
+			return
+		}
+		recordset.Name, recordset.Type = name, recordType
+		if !validRecordset(w, zone, recordset) {
+			return
+		}
+		zone.recordsets[key] = recordset
+		zone.touch()
+		status := http.StatusOK
+		if !exists {
+			status = http.StatusCreated
+		}
+		respond(w, r, status, recordset)
+	case http.MethodDelete:
+		if !exists {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Recordset %s %s not found", name, recordType))
+			return
+		}
+		if !precondition(w, r, current) {
+			return
+		}
+		delete(zone.recordsets, key)
+		zone.touch()
+		respondNoContent(w)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+// serveDNSChangelists serves the changelists used to create the SOA and NS
+// records of new primary zones
+func (s *Server) serveDNSChangelists(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 && r.Method == http.MethodPost {
+		zone, ok := s.dns.zones[r.URL.Query().Get("zone")]
+		if !ok {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Zone %s not found", r.URL.Query().Get("zone")))
+			return
+		}
+		if zone.changelist != nil {
+			problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("A changelist already exists for zone %s", zone.Zone))
+			return
+		}
+
+		recordsets := make(map[string]*dnsRecordset, len(zone.recordsets)+2)
+		for key, recordset := range zone.recordsets {
+			recordsets[key] = recordset
+		}
+		if zone.Type == "PRIMARY" {
+			for _, recordset := range defaultRecordsets(zone.Zone) {
+				if _, ok := recordsets[recordset.key()]; !ok {
+					recordsets[recordset.key()] = recordset
+				}
+			}
+		}
+		zone.changelist = &dnsChangelist{
+			Zone:             zone.Zone,
+			ChangeTag:        uuid.New().String(),
+			ZoneVersionID:    zone.VersionID,
+			LastModifiedDate: now().Format(time.RFC3339),
+			recordsets:       recordsets,
+		}
+		respond(w, r, http.StatusCreated, zone.changelist)
+		return
+	}
+	if len(path) == 0 {
+		methodNotAllowed(w, r)
+		return
+	}
+
+	zone, ok := s.dns.zones[path[0]]
+	if !ok || zone.changelist == nil {
+		problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("No changelist for zone %s", path[0]))
+		return
+	}
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		zone.changelist.Stale = zone.changelist.ZoneVersionID != zone.VersionID
+		respond(w, r, http.StatusOK, zone.changelist)
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		zone.changelist = nil
+		respondNoContent(w)
+	case len(path) == 2 && path[1] == "submit" && r.Method == http.MethodPost:
+		if zone.changelist.ZoneVersionID != zone.VersionID {
+			problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("The changelist of zone %s is stale", zone.Zone))
+			return
+		}
+		zone.recordsets = zone.changelist.recordsets
+		zone.changelist = nil
+		zone.ActivationState = "ACTIVE"
+		zone.LastActivationDate = now().Format(time.RFC3339)
+		zone.touch()
+		respondNoContent(w)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) sortedZones() []*dnsZone {
+	zones := make([]*dnsZone, 0, len(s.dns.zones))
+	for _, zone := range s.dns.zones {
+		zones = append(zones, zone)
+	}
+	sort.Slice(zones, func(i, j int) bool { return zones[i].Zone < zones[j].Zone })
+	return zones
+}
+
+// touch records a modification of the zone with a new version
+func (zone *dnsZone) touch() {
+	zone.LastModifiedBy = "edgegridtest"
+	zone.LastModifiedDate = now().Format(time.RFC3339)
+	zone.VersionID = uuid.New().String()
+}
+
+func (zone *dnsZone) sortedRecordsets() []*dnsRecordset {
+	recordsets := make([]*dnsRecordset, 0, len(zone.recordsets))
+	for _, recordset := range zone.recordsets {
+		recordsets = append(recordsets, recordset)
+	}
+	sort.Slice(recordsets, func(i, j int) bool { return recordsets[i].key() < recordsets[j].key() })
+	return recordsets
+}
+
+func (recordset *dnsRecordset) key() string {
+	return strings.ToLower(recordset.Name) + " " + strings.ToUpper(recordset.Type)
+}
+
+// validRecordset checks that recordset belongs to zone and is complete
+func validRecordset(w http.ResponseWriter, zone *dnsZone, recordset *dnsRecordset) bool {
+	recordset.Type = strings.ToUpper(recordset.Type)
+	name := strings.ToLower(recordset.Name)
+	switch {
+	case name != zone.Zone && !strings.HasSuffix(name, "."+zone.Zone):
+		problem(w, http.StatusBadRequest, "Bad Request", fmt.Sprintf("Recordset %s is not in zone %s", recordset.Name, zone.Zone))
+		return false
+	case recordset.Type == "" || recordset.TTL <= 0 || len(recordset.Rdata) == 0:
+		problem(w, http.StatusBadRequest, "Bad Request", fmt.Sprintf("Recordset %s needs a type, a TTL and rdata", recordset.Name))
+		return false
+	}
+	return true
+}
+
+// defaultRecordsets returns the SOA and NS records created for a new primary zone
+func defaultRecordsets(zone string) []*dnsRecordset {
+	return []*dnsRecordset{
+		{Name: zone, Type: "SOA", TTL: 86400, Rdata: []string{"a1-1.akam.net. hostmaster." + zone + ". 1 3600 600 604800 300"}},
+		{Name: zone, Type: "NS", TTL: 86400, Rdata: []string{"a1-1.akam.net.", "a2-2.akam.net."}},
+	}
+}
+
+// listed tells whether value is in the comma separated list, or list is empty
+func listed(list, value string) bool {
+	if list == "" {
+		return true
+	}
+	for _, item := range strings.Split(list, ",") {
+		if strings.EqualFold(strings.TrimSpace(item), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// paginate returns the page and page size asked for in query, and the bounds
+// of the page in a list of total items
+func paginate(query map[string][]string, total int) (page, pageSize, first, last int) {
+	page, pageSize = 1, 25
+	if values := query["page"]; len(values) > 0 {
+		if n, err := strconv.Atoi(values[0]); err == nil && n > 0 {
+			page = n
+		}
+	}
+	if values := query["pageSize"]; len(values) > 0 {
+		if n, err := strconv.Atoi(values[0]); err == nil && n > 0 {
+			pageSize = n
+		}
+	}
+	if values := query["showAll"]; len(values) > 0 && values[0] == "true" {
+		page, pageSize = 1, total
+		if pageSize == 0 {
+			pageSize = 1
+		}
+	}
+
+	first = (page - 1) * pageSize
+	if first > total {
+		first = total
+	}
+	last = first + pageSize
+	if last > total {
+		last = total
+	}
+	return page, pageSize, first, last
+}
@@ -0,0 +1,303 @@
+package edgegridtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type gtmState struct {
+	domains map[string]*gtmDomain
+}
+
+// gtmDomain holds the attributes of a GTM domain as sent by the client, so
+// that the ones the Server knows nothing about round-trip too
+type gtmDomain struct {
+	fields     map[string]interface{}
+	properties map[string]map[string]interface{}
+	status     *gtmStatus
+}
+
+type gtmStatus struct {
+	ChangeID              string `json:"changeId"`
+	Message               string `json:"message"`
+	PassingValidation     bool   `json:"passingValidation"`
+	PropagationStatus     string `json:"propagationStatus"`
+	PropagationStatusDate string `json:"propagationStatusDate"`
+
+	changed time.Time
+}
+
+func newGTMState() gtmState {
+	return gtmState{domains: make(map[string]*gtmDomain)}
+}
+
+// serveGTM serves /config-gtm/v1/{path}
+func (s *Server) serveGTM(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 || path[0] != "domains" {
+		notFound(w, r)
+		return
+	}
+	s.settlePropagation()
+
+	if len(path) == 1 {
+		s.serveGTMDomains(w, r)
+		return
+	}
+
+	domain, ok := s.gtm.domains[path[1]]
+	if !ok {
+		problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Domain %s not found", path[1]))
+		return
+	}
+	switch {
+	case len(path) == 2:
+		s.serveGTMDomain(w, r, path[1], domain)
+	case len(path) == 4 && path[2] == "status" && path[3] == "current" && r.Method == http.MethodGet:
+		respond(w, r, http.StatusOK, domain.status)
+	case len(path) == 3 && path[2] == "properties" && r.Method == http.MethodGet:
+		respond(w, r, http.StatusOK, map[string]interface{}{"items": domain.sortedProperties()})
+	case len(path) == 4 && path[2] == "properties":
+		s.serveGTMProperty(w, r, path[1], domain, path[3])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) serveGTMDomains(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		items := []map[string]interface{}{}
+		for _, name := range s.sortedDomainNames() {
+			domain := s.gtm.domains[name]
+			items = append(items, map[string]interface{}{
+				"acgId":        domain.fields["acgId"],
+				"lastModified": domain.fields["lastModified"],
+				"links":        domain.fields["links"],
+				"name":         name,
+				"status":       domain.status.Message,
+			})
+		}
+		respond(w, r, http.StatusOK, map[string]interface{}{"items": items})
+	case http.MethodPost:
+		fields := map[string]interface{}{}
+		if !decode(w, r, &fields) {
+			return
+		}
+		name, _ := fields["name"].(string)
+		if !strings.HasSuffix(name, ".akadns.net") {
+			problem(w, http.StatusBadRequest, "Bad Request", "The domain name must end with .akadns.net")
+			return
+		}
+		if _, ok := s.gtm.domains[name]; ok {
+			problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Domain %s already exists", name))
+			return
+		}
+		if r.URL.Query().Get("contractId") == "" {
+			problem(w, http.StatusBadRequest, "Bad Request", "contractId is required")
+			return
+		}
+
+		domain := &gtmDomain{properties: make(map[string]map[string]interface{})}
+		domain.fields = map[string]interface{}{"acgId": r.URL.Query().Get("contractId")}
+		if !domain.update(w, name, fields) {
+			return
+		}
+		s.gtm.domains[name] = domain
+		respond(w, r, http.StatusCreated, map[string]interface{}{"resource": domain.resource(name), "status": domain.status})
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveGTMDomain(w http.ResponseWriter, r *http.Request, name string, domain *gtmDomain) {
+	switch r.Method {
+	case http.MethodGet:
+		respond(w, r, http.StatusOK, domain.resource(name))
+	case http.MethodPut:
+		if !precondition(w, r, domain.resource(name)) {
+			return
+		}
+		fields := map[string]interface{}{}
+		if !decode(w, r, &fields) {
+			return
+		}
+		if fields["name"] != name {
+			problem(w, http.StatusBadRequest, "Bad Request", "The domain name cannot be changed")
+			return
+		}
+		if !domain.update(w, name, fields) {
+			return
+		}
+		respond(w, r, http.StatusOK, map[string]interface{}{"resource": domain.resource(name), "status": domain.status})
+	case http.MethodDelete:
+		if !precondition(w, r, domain.resource(name)) {
+			return
+		}
+		delete(s.gtm.domains, name)
+		respond(w, r, http.StatusOK, map[string]interface{}{"resource": nil, "status": newGTMStatus()})
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveGTMProperty(w http.ResponseWriter, r *http.Request, domainName string, domain *gtmDomain, name string) {
+	current, exists := domain.properties[name]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Property %s not found in domain %s", name, domainName))
+			return
+		}
+		respond(w, r, http.StatusOK, current)
+	case http.MethodPut:
+		if exists && !precondition(w, r, current) {
+			return
+		}
+		property := map[string]interface{}{}
+		if !decode(w, r, &property) {
+			return
+		}
+		if property["name"] != name {
+			problem(w, http.StatusBadRequest, "Bad Request", "The property name must match the URL")
+			return
+		}
+		if !validGTMProperty(w, property) {
+			return
+		}
+		property["links"] = gtmLinks(fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", domainName, name))
+		domain.properties[name] = property
+		domain.changed()
+
+		status := http.StatusOK
+		if !exists {
+			status = http.StatusCreated
+		}
+		respond(w, r, status, map[string]interface{}{"resource": property, "status": domain.status})
+	case http.MethodDelete:
+		if !exists {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Property %s not found in domain %s", name, domainName))
+			return
+		}
+		if !precondition(w, r, current) {
+			return
+		}
+		delete(domain.properties, name)
+		domain.changed()
+		respond(w, r, http.StatusOK, map[string]interface{}{"resource": nil, "status": domain.status})
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+// settlePropagation completes the GTM changes older than PropagationDelay
+func (s *Server) settlePropagation() {
+	deadline := now().Add(-s.PropagationDelay)
+	for _, domain := range s.gtm.domains {
+		status := domain.status
+		if status.PropagationStatus == "PENDING" && !status.changed.After(deadline) {
+			status.PropagationStatus = "COMPLETE"
+			status.PropagationStatusDate = now().Format(time.RFC3339)
+			status.Message = "Current configuration has been propagated to all GTM nameservers"
+		}
+	}
+}
+
+func (s *Server) sortedDomainNames() []string {
+	names := make([]string, 0, len(s.gtm.domains))
+	for name := range s.gtm.domains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// update replaces the attributes of the domain with fields, including its properties if given
+func (domain *gtmDomain) update(w http.ResponseWriter, name string, fields map[string]interface{}) bool {
+	if items, ok := fields["properties"].([]interface{}); ok {
+		properties := make(map[string]map[string]interface{}, len(items))
+		for _, item := range items {
+			property, _ := item.(map[string]interface{})
+			if !validGTMProperty(w, property) {
+				return false
+			}
+			propertyName := property["name"].(string)
+			property["links"] = gtmLinks(fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", name, propertyName))
+			properties[propertyName] = property
+		}
+		domain.properties = properties
+	}
+	delete(fields, "properties")
+	delete(fields, "status")
+
+	fields["acgId"] = domain.fields["acgId"]
+	fields["lastModifiedBy"] = "edgegridtest"
+	fields["links"] = gtmLinks("/config-gtm/v1/domains/" + name)
+	domain.fields = fields
+	domain.changed()
+	return true
+}
+
+// changed records a modification of the domain, to be propagated
+func (domain *gtmDomain) changed() {
+	domain.status = newGTMStatus()
+	domain.fields["lastModified"] = domain.status.PropagationStatusDate
+}
+
+// resource returns the domain as served by the API, with its properties and status
+func (domain *gtmDomain) resource(name string) map[string]interface{} {
+	resource := make(map[string]interface{}, len(domain.fields)+2)
+	for key, value := range domain.fields {
+		resource[key] = value
+	}
+	resource["name"] = name
+	resource["properties"] = domain.sortedProperties()
+	resource["status"] = domain.status
+	return resource
+}
+
+func (domain *gtmDomain) sortedProperties() []map[string]interface{} {
+	names := make([]string, 0, len(domain.properties))
+	for name := range domain.properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	properties := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		properties = append(properties, domain.properties[name])
+	}
+	return properties
+}
+
+func newGTMStatus() *gtmStatus {
+	changed := now()
+	return &gtmStatus{
+		ChangeID:              uuid.New().String(),
+		Message:               "Change Pending",
+		PassingValidation:     true,
+		PropagationStatus:     "PENDING",
+		PropagationStatusDate: changed.Format(time.RFC3339),
+		changed:               changed,
+	}
+}
+
+// validGTMProperty checks that property has a name and a type
+func validGTMProperty(w http.ResponseWriter, property map[string]interface{}) bool {
+	name, _ := property["name"].(string)
+	propertyType, _ := property["type"].(string)
+	if name == "" || propertyType == "" {
+		problem(w, http.StatusBadRequest, "Bad Request", "A property needs a name and a type")
+		return false
+	}
+	return true
+}
+
+func gtmLinks(self string) []map[string]string {
+	return []map[string]string{{"rel": "self", "href": self}}
+}
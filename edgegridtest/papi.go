@@ -0,0 +1,603 @@
+package edgegridtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// PAPI activation and version statuses, and networks
+const (
+	papiActive      = "ACTIVE"
+	papiInactive    = "INACTIVE"
+	papiPending     = "PENDING"
+	papiAborted     = "ABORTED"
+	papiDeactivated = "DEACTIVATED"
+
+	papiStaging    = "STAGING"
+	papiProduction = "PRODUCTION"
+
+	papiActivate   = "ACTIVATE"
+	papiDeactivate = "DEACTIVATE"
+)
+
+type papiState struct {
+	contracts  []*papiContract
+	groups     []*papiGroup
+	properties map[string]*papiProperty
+}
+
+type papiContract struct {
+	ContractID       string `json:"contractId"`
+	ContractTypeName string `json:"contractTypeName"`
+}
+
+type papiGroup struct {
+	GroupName   string   `json:"groupName"`
+	GroupID     string   `json:"groupId"`
+	ContractIDs []string `json:"contractIds"`
+}
+
+type papiProperty struct {
+	AccountID         string `json:"accountId"`
+	ContractID        string `json:"contractId"`
+	GroupID           string `json:"groupId"`
+	PropertyID        string `json:"propertyId"`
+	PropertyName      string `json:"propertyName"`
+	LatestVersion     int    `json:"latestVersion"`
+	StagingVersion    *int   `json:"stagingVersion"`
+	ProductionVersion *int   `json:"productionVersion"`
+	ProductID         string `json:"productId,omitempty"`
+	RuleFormat        string `json:"ruleFormat,omitempty"`
+	Note              string `json:"note,omitempty"`
+
+	versions    []*papiVersion
+	activations []*papiActivation
+}
+
+type papiVersion struct {
+	PropertyVersion  int    `json:"propertyVersion"`
+	UpdatedByUser    string `json:"updatedByUser"`
+	UpdatedDate      string `json:"updatedDate"`
+	ProductionStatus string `json:"productionStatus"`
+	StagingStatus    string `json:"stagingStatus"`
+	Etag             string `json:"etag"`
+	ProductID        string `json:"productId,omitempty"`
+	Note             string `json:"note,omitempty"`
+	RuleFormat       string `json:"ruleFormat,omitempty"`
+
+	rules json.RawMessage
+}
+
+type papiActivation struct {
+	ActivationID        string          `json:"activationId"`
+	ActivationType      string          `json:"activationType"`
+	AcknowledgeWarnings []string        `json:"acknowledgeWarnings,omitempty"`
+	ComplianceRecord    json.RawMessage `json:"complianceRecord,omitempty"`
+	FastPush            bool            `json:"fastPush,omitempty"`
+	PropertyName        string          `json:"propertyName"`
+	PropertyID          string          `json:"propertyId"`
+	PropertyVersion     int             `json:"propertyVersion"`
+	Network             string          `json:"network"`
+	Status              string          `json:"status"`
+	SubmitDate          string          `json:"submitDate"`
+	UpdateDate          string          `json:"updateDate"`
+	Note                string          `json:"note,omitempty"`
+	NotifyEmails        []string        `json:"notifyEmails"`
+
+	submitted time.Time
+}
+
+const papiDefaultRules = `{"name":"default","options":{"is_secure":false},"behaviors":[],"children":[]}`
+
+func newPapiState() papiState {
+	return papiState{properties: make(map[string]*papiProperty)}
+}
+
+func (p *papiState) addGroup(groupID, groupName string, contractIDs ...string) {
+	for _, contractID := range contractIDs {
+		if p.contract(contractID) == nil {
+			p.contracts = append(p.contracts, &papiContract{ContractID: contractID, ContractTypeName: "Direct Customer"})
+		}
+	}
+	p.groups = append(p.groups, &papiGroup{GroupID: groupID, GroupName: groupName, ContractIDs: contractIDs})
+}
+
+func (p *papiState) contract(contractID string) *papiContract {
+	for _, contract := range p.contracts {
+		if contract.ContractID == contractID {
+			return contract
+		}
+	}
+	return nil
+}
+
+func (p *papiState) group(groupID, contractID string) *papiGroup {
+	for _, group := range p.groups {
+		if group.GroupID != groupID {
+			continue
+		}
+		for _, id := range group.ContractIDs {
+			if id == contractID {
+				return group
+			}
+		}
+	}
+	return nil
+}
+
+// servePapi serves /papi/v1/{path}
+func (s *Server) servePapi(w http.ResponseWriter, r *http.Request, path []string) {
+	s.settleActivations()
+
+	switch {
+	case len(path) == 1 && path[0] == "contracts" && r.Method == http.MethodGet:
+		respond(w, r, http.StatusOK, map[string]interface{}{
+			"accountId": AccountID,
+			"contracts": map[string]interface{}{"items": s.papi.contracts},
+		})
+	case len(path) == 1 && path[0] == "groups" && r.Method == http.MethodGet:
+		respond(w, r, http.StatusOK, map[string]interface{}{
+			"accountId":   AccountID,
+			"accountName": "edgegridtest",
+			"groups":      map[string]interface{}{"items": s.papi.groups},
+		})
+	case len(path) == 1 && path[0] == "properties":
+		s.servePapiProperties(w, r)
+	case len(path) >= 2 && path[0] == "properties":
+		property, ok := s.papi.properties[path[1]]
+		if !ok {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Property %s not found", path[1]))
+			return
+		}
+		s.servePapiProperty(w, r, property, path[2:])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) servePapiProperties(w http.ResponseWriter, r *http.Request) {
+	contractID, groupID := r.URL.Query().Get("contractId"), r.URL.Query().Get("groupId")
+	if s.papi.group(groupID, contractID) == nil {
+		problem(w, http.StatusForbidden, "Forbidden", fmt.Sprintf("Group %s of contract %s is not accessible", groupID, contractID))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		items := []*papiProperty{}
+		for _, property := range s.sortedProperties() {
+			if property.ContractID == contractID && property.GroupID == groupID {
+				items = append(items, property)
+			}
+		}
+		respond(w, r, http.StatusOK, map[string]interface{}{"properties": map[string]interface{}{"items": items}})
+	case http.MethodPost:
+		var create struct {
+			PropertyName string `json:"propertyName"`
+			ProductID    string `json:"productId"`
+			RuleFormat   string `json:"ruleFormat"`
+			CloneFrom    *struct {
+				PropertyID           string `json:"propertyId"`
+				Version              int    `json:"version"`
+				CloneFromVersionEtag string `json:"cloneFromVersionEtag"`
+			} `json:"cloneFrom"`
+		}
+		if !decode(w, r, &create) {
+			return
+		}
+		if create.PropertyName == "" {
+			problem(w, http.StatusBadRequest, "Bad Request", "propertyName is required")
+			return
+		}
+		for _, property := range s.papi.properties {
+			if property.PropertyName == create.PropertyName {
+				problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Property %s already exists", create.PropertyName))
+				return
+			}
+		}
+
+		rules := json.RawMessage(papiDefaultRules)
+		if create.CloneFrom != nil && create.CloneFrom.PropertyID != "" {
+			source, ok := s.papi.properties[create.CloneFrom.PropertyID]
+			if !ok {
+				problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Property %s not found", create.CloneFrom.PropertyID))
+				return
+			}
+			version := source.version(create.CloneFrom.Version)
+			if version == nil {
+				problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Version %d of property %s not found", create.CloneFrom.Version, source.PropertyID))
+				return
+			}
+			if create.CloneFrom.CloneFromVersionEtag != "" && create.CloneFrom.CloneFromVersionEtag != version.Etag {
+				problem(w, http.StatusPreconditionFailed, "Precondition Failed", "cloneFromVersionEtag does not match the version")
+				return
+			}
+			rules = version.rules
+			if create.ProductID == "" {
+				create.ProductID = source.ProductID
+			}
+		}
+		if create.ProductID == "" {
+			problem(w, http.StatusBadRequest, "Bad Request", "productId is required")
+			return
+		}
+		if create.RuleFormat == "" {
+			create.RuleFormat = "latest"
+		}
+
+		property := &papiProperty{
+			AccountID:    AccountID,
+			ContractID:   contractID,
+			GroupID:      groupID,
+			PropertyID:   s.nextID("prp_"),
+			PropertyName: create.PropertyName,
+			ProductID:    create.ProductID,
+			RuleFormat:   create.RuleFormat,
+		}
+		property.addVersion(rules, "")
+		s.papi.properties[property.PropertyID] = property
+
+		respond(w, r, http.StatusCreated, map[string]string{"propertyLink": property.link("")})
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) servePapiProperty(w http.ResponseWriter, r *http.Request, property *papiProperty, path []string) {
+	switch {
+	case len(path) == 0:
+		switch r.Method {
+		case http.MethodGet:
+			respond(w, r, http.StatusOK, map[string]interface{}{"properties": map[string]interface{}{"items": []*papiProperty{property}}})
+		case http.MethodDelete:
+			if property.StagingVersion != nil || property.ProductionVersion != nil {
+				problem(w, http.StatusConflict, "Conflict", "An active property cannot be deleted, deactivate it first")
+				return
+			}
+			if !precondition(w, r, map[string]interface{}{"properties": map[string]interface{}{"items": []*papiProperty{property}}}) {
+				return
+			}
+			delete(s.papi.properties, property.PropertyID)
+			respond(w, r, http.StatusOK, map[string]string{"message": "Deletion Successful."})
+		default:
+			methodNotAllowed(w, r)
+		}
+	case path[0] == "versions":
+		s.servePapiVersions(w, r, property, path[1:])
+	case path[0] == "activations":
+		s.servePapiActivations(w, r, property, path[1:])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) servePapiVersions(w http.ResponseWriter, r *http.Request, property *papiProperty, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			items := make([]*papiVersion, 0, len(property.versions))
+			for i := len(property.versions) - 1; i >= 0; i-- {
+				items = append(items, property.versions[i])
+			}
+			respond(w, r, http.StatusOK, property.versionsBody(items...))
+		case http.MethodPost:
+			var create struct {
+				CreateFromVersion     int    `json:"createFromVersion"`
+				CreateFromVersionEtag string `json:"createFromVersionEtag"`
+			}
+			if !decode(w, r, &create) {
+				return
+			}
+			from := property.version(create.CreateFromVersion)
+			if from == nil {
+				problem(w, http.StatusBadRequest, "Bad Request", fmt.Sprintf("createFromVersion %d does not exist", create.CreateFromVersion))
+				return
+			}
+			if create.CreateFromVersionEtag != "" && create.CreateFromVersionEtag != from.Etag {
+				problem(w, http.StatusPreconditionFailed, "Precondition Failed", "createFromVersionEtag does not match the version")
+				return
+			}
+			version := property.addVersion(from.rules, from.Note)
+			respond(w, r, http.StatusCreated, map[string]string{"versionLink": property.link(fmt.Sprintf("/versions/%d", version.PropertyVersion))})
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	var version *papiVersion
+	if path[0] == "latest" {
+		number := property.LatestVersion
+		switch r.URL.Query().Get("activatedOn") {
+		case papiStaging:
+			number = versionNumber(property.StagingVersion)
+		case papiProduction:
+			number = versionNumber(property.ProductionVersion)
+		}
+		version = property.version(number)
+	} else if number, err := strconv.Atoi(path[0]); err == nil {
+		version = property.version(number)
+	}
+	if version == nil {
+		problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Version %s of property %s not found", path[0], property.PropertyID))
+		return
+	}
+
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		respond(w, r, http.StatusOK, property.versionsBody(version))
+	case len(path) == 2 && path[1] == "rules":
+		s.servePapiRules(w, r, property, version)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) servePapiRules(w http.ResponseWriter, r *http.Request, property *papiProperty, version *papiVersion) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPut:
+		if version.StagingStatus != papiInactive || version.ProductionStatus != papiInactive {
+			problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Version %d has been activated and cannot be modified", version.PropertyVersion))
+			return
+		}
+		if !preconditionTagged(w, r, `"`+version.Etag+`"`) {
+			return
+		}
+		var update struct {
+			Rules json.RawMessage `json:"rules"`
+		}
+		if !decode(w, r, &update) {
+			return
+		}
+		if len(update.Rules) == 0 || string(update.Rules) == "null" {
+			problem(w, http.StatusBadRequest, "Bad Request", "rules is required")
+			return
+		}
+		version.rules = update.Rules
+		version.UpdatedDate = now().Format(time.RFC3339)
+		version.Etag = papiEtag(property.PropertyID, version)
+	default:
+		methodNotAllowed(w, r)
+		return
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"accountId":       AccountID,
+		"contractId":      property.ContractID,
+		"groupId":         property.GroupID,
+		"propertyId":      property.PropertyID,
+		"propertyVersion": version.PropertyVersion,
+		"etag":            version.Etag,
+		"ruleFormat":      property.RuleFormat,
+		"rules":           version.rules,
+	})
+	if err != nil {
+		problem(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+		return
+	}
+	respondTagged(w, r, http.StatusOK, body, `"`+version.Etag+`"`)
+}
+
+func (s *Server) servePapiActivations(w http.ResponseWriter, r *http.Request, property *papiProperty, path []string) {
+	activationsBody := func(activations ...*papiActivation) map[string]interface{} {
+		return map[string]interface{}{
+			"accountId":   AccountID,
+			"contractId":  property.ContractID,
+			"groupId":     property.GroupID,
+			"activations": map[string]interface{}{"items": activations},
+		}
+	}
+
+	if len(path) == 1 {
+		var activation *papiActivation
+		for _, a := range property.activations {
+			if a.ActivationID == path[0] {
+				activation = a
+			}
+		}
+		if activation == nil {
+			problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("Activation %s not found", path[0]))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			respond(w, r, http.StatusOK, activationsBody(activation))
+		case http.MethodDelete:
+			if !property.abort(activation) {
+				problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Activation %s is not pending", activation.ActivationID))
+				return
+			}
+			respond(w, r, http.StatusOK, activationsBody(activation))
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		items := make([]*papiActivation, 0, len(property.activations))
+		for i := len(property.activations) - 1; i >= 0; i-- {
+			items = append(items, property.activations[i])
+		}
+		respond(w, r, http.StatusOK, activationsBody(items...))
+	case http.MethodPost:
+		activation := &papiActivation{}
+		if !decode(w, r, activation) {
+			return
+		}
+		if activation.Network != papiStaging && activation.Network != papiProduction {
+			problem(w, http.StatusBadRequest, "Bad Request", "network must be STAGING or PRODUCTION")
+			return
+		}
+		if activation.ActivationType == "" {
+			activation.ActivationType = papiActivate
+		}
+		if activation.ActivationType != papiActivate && activation.ActivationType != papiDeactivate {
+			problem(w, http.StatusBadRequest, "Bad Request", "activationType must be ACTIVATE or DEACTIVATE")
+			return
+		}
+		version := property.version(activation.PropertyVersion)
+		if version == nil {
+			problem(w, http.StatusBadRequest, "Bad Request", fmt.Sprintf("Version %d does not exist", activation.PropertyVersion))
+			return
+		}
+		for _, a := range property.activations {
+			if a.Network == activation.Network && a.Status == papiPending {
+				problem(w, http.StatusConflict, "Conflict", fmt.Sprintf("Activation %s is already pending on %s", a.ActivationID, a.Network))
+				return
+			}
+		}
+
+		activation.ActivationID = s.nextID("atv_")
+		activation.PropertyID = property.PropertyID
+		activation.PropertyName = property.PropertyName
+		activation.Status = papiPending
+		activation.submitted = now()
+		activation.SubmitDate = activation.submitted.Format(time.RFC3339)
+		activation.UpdateDate = activation.SubmitDate
+		if activation.NotifyEmails == nil {
+			activation.NotifyEmails = []string{}
+		}
+		property.activations = append(property.activations, activation)
+		version.setStatus(activation.Network, papiPending)
+
+		respond(w, r, http.StatusCreated, map[string]string{"activationLink": property.link("/activations/" + activation.ActivationID)})
+	case http.MethodDelete:
+		// Cancels the pending activation, if any
+		for _, activation := range property.activations {
+			if property.abort(activation) {
+				respond(w, r, http.StatusOK, activationsBody(activation))
+				return
+			}
+		}
+		problem(w, http.StatusConflict, "Conflict", "There is no pending activation")
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+// settleActivations completes the pending activations older than ActivationDelay
+func (s *Server) settleActivations() {
+	deadline := now().Add(-s.ActivationDelay)
+	for _, property := range s.papi.properties {
+		for _, activation := range property.activations {
+			if activation.Status == papiPending && !activation.submitted.After(deadline) {
+				property.complete(activation)
+			}
+		}
+	}
+}
+
+func (s *Server) sortedProperties() []*papiProperty {
+	properties := make([]*papiProperty, 0, len(s.papi.properties))
+	for _, property := range s.papi.properties {
+		properties = append(properties, property)
+	}
+	sort.Slice(properties, func(i, j int) bool { return properties[i].PropertyID < properties[j].PropertyID })
+	return properties
+}
+
+// link returns the URL of the property, or of one of its sub-resources
+func (property *papiProperty) link(path string) string {
+	return fmt.Sprintf("/papi/v1/properties/%s%s?contractId=%s&groupId=%s", property.PropertyID, path, property.ContractID, property.GroupID)
+}
+
+func (property *papiProperty) version(number int) *papiVersion {
+	if number < 1 || number > len(property.versions) {
+		return nil
+	}
+	return property.versions[number-1]
+}
+
+func (property *papiProperty) addVersion(rules json.RawMessage, note string) *papiVersion {
+	version := &papiVersion{
+		PropertyVersion:  len(property.versions) + 1,
+		UpdatedByUser:    "edgegridtest",
+		UpdatedDate:      now().Format(time.RFC3339),
+		ProductionStatus: papiInactive,
+		StagingStatus:    papiInactive,
+		ProductID:        property.ProductID,
+		Note:             note,
+		RuleFormat:       property.RuleFormat,
+		rules:            rules,
+	}
+	version.Etag = papiEtag(property.PropertyID, version)
+	property.versions = append(property.versions, version)
+	property.LatestVersion = version.PropertyVersion
+	return version
+}
+
+func (property *papiProperty) versionsBody(versions ...*papiVersion) map[string]interface{} {
+	return map[string]interface{}{
+		"propertyId":   property.PropertyID,
+		"propertyName": property.PropertyName,
+		"accountId":    AccountID,
+		"contractId":   property.ContractID,
+		"groupId":      property.GroupID,
+		"versions":     map[string]interface{}{"items": versions},
+	}
+}
+
+// complete makes a pending activation ACTIVE, and updates the property and versions
+func (property *papiProperty) complete(activation *papiActivation) {
+	activation.Status = papiActive
+	activation.UpdateDate = now().Format(time.RFC3339)
+
+	active := &property.StagingVersion
+	if activation.Network == papiProduction {
+		active = &property.ProductionVersion
+	}
+	if previous := property.version(versionNumber(*active)); previous != nil {
+		previous.setStatus(activation.Network, papiDeactivated)
+	}
+
+	version := property.version(activation.PropertyVersion)
+	if activation.ActivationType == papiDeactivate {
+		version.setStatus(activation.Network, papiDeactivated)
+		*active = nil
+		return
+	}
+	version.setStatus(activation.Network, papiActive)
+	number := version.PropertyVersion
+	*active = &number
+}
+
+// abort aborts activation if it is pending
+func (property *papiProperty) abort(activation *papiActivation) bool {
+	if activation.Status != papiPending {
+		return false
+	}
+	activation.Status = papiAborted
+	activation.UpdateDate = now().Format(time.RFC3339)
+	if version := property.version(activation.PropertyVersion); version != nil {
+		version.setStatus(activation.Network, papiInactive)
+	}
+	return true
+}
+
+func (version *papiVersion) setStatus(network, status string) {
+	if network == papiProduction {
+		version.ProductionStatus = status
+	} else {
+		version.StagingStatus = status
+	}
+}
+
+// papiEtag returns the etag of a version, which changes with its rules
+func papiEtag(propertyID string, version *papiVersion) string {
+	tag := etagOf([]byte(fmt.Sprintf("%s/%d/%s/%s", propertyID, version.PropertyVersion, version.UpdatedDate, version.rules)))
+	return tag[1 : len(tag)-1]
+}
+
+func versionNumber(version *int) int {
+	if version == nil {
+		return 0
+	}
+	return *version
+}
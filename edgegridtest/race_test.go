@@ -0,0 +1,106 @@
+package edgegridtest
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configdns-v2"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/papi-v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The tests below are meant to be run with the race detector, go test -race
+
+func TestServer_ConcurrentPapiCalls(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := papi.New(server.Session())
+
+	groups, err := c.GetGroups()
+	require.NoError(t, err)
+	contracts, err := c.GetContracts()
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			_, err := c.GetGroups()
+			assert.NoError(t, err)
+			_, err = c.GetContracts()
+			assert.NoError(t, err)
+
+			property := c.NewProperty(c.NewProperties())
+			property.Contract = contracts.Contracts.Items[0]
+			property.Group = groups.Groups.Items[0]
+			property.PropertyName = fmt.Sprintf("www%d.example.com", i)
+			property.ProductID = "prd_Fresca"
+			if !assert.NoError(t, c.CreateProperty(property)) {
+				return
+			}
+			rules, err := c.GetRules(property)
+			if !assert.NoError(t, err) {
+				return
+			}
+			rules.Rule.Comments = "Updated"
+			assert.NoError(t, c.UpdateRules(rules))
+		}(i)
+	}
+	wg.Wait()
+
+	properties, err := c.GetProperties(contracts.Contracts.Items[0], groups.Groups.Items[0])
+	require.NoError(t, err)
+	assert.Len(t, properties.Properties.Items, 20)
+}
+
+func TestServer_ConcurrentDNSCalls(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := dnsv2.New(server.Session())
+
+	zone := dnsv2.NewZone(dnsv2.ZoneCreate{Zone: "example.com", Type: "primary"})
+	require.NoError(t, c.SaveZone(zone, dnsv2.ZoneQueryString{Contract: "1-2AB34C"}))
+	require.NoError(t, c.SaveChangelist(zone))
+	require.NoError(t, c.SubmitChangelist(zone))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		// Records of the same zone, serialized
+		go func(i int) {
+			defer wg.Done()
+			record := &dnsv2.RecordBody{
+				Name:       fmt.Sprintf("host%d.example.com", i),
+				RecordType: "A",
+				TTL:        300,
+				Target:     []string{fmt.Sprintf("192.0.2.%d", i+1)},
+			}
+			assert.NoError(t, c.SaveRecord(record, "example.com"))
+		}(i)
+		// Other zones, in parallel
+		go func(i int) {
+			defer wg.Done()
+			other := dnsv2.NewZone(dnsv2.ZoneCreate{Zone: fmt.Sprintf("example%d.net", i), Type: "secondary", Masters: []string{"192.0.2.1"}})
+			assert.NoError(t, c.SaveZone(other, dnsv2.ZoneQueryString{Contract: "1-2AB34C"}))
+			_, err := c.ListZones()
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	count := 0
+	require.NoError(t, c.IterRecordsets("example.com").ForEach(func(recordset dnsv2.Recordset) error {
+		count++
+		return nil
+	}))
+	// The SOA and NS records, and the 20 A records
+	assert.Equal(t, 22, count)
+
+	zones, err := c.ListZones(dnsv2.ZoneListQueryArgs{ShowAll: true})
+	require.NoError(t, err)
+	assert.Len(t, zones.Zones, 21)
+}
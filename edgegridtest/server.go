@@ -0,0 +1,234 @@
+// Package edgegridtest provides a local stand-in for the Akamai APIs wrapped
+// by this library, to run end-to-end tests without network access or mocks
+// of individual URLs.
+//
+// The Server keeps state like the real APIs do, for PAPI properties, versions,
+// rules and activations, Config DNS v2 zones and recordsets, and GTM domains
+// and properties. Requests must be signed with the credentials of Config.
+//
+//	server := edgegridtest.NewServer()
+//	defer server.Close()
+//
+//	zones, err := dnsv2.New(server.Session()).ListZones()
+package edgegridtest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+)
+
+const (
+	// AccountID is the account of the resources of the Server
+	AccountID = "act_1-TEST"
+
+	// ContractID is the contract the Server starts with
+	ContractID = "ctr_1-TEST"
+
+	// GroupID is the group the Server starts with, in ContractID
+	GroupID = "grp_10000"
+)
+
+// Server is an httptest.Server emulating the stateful parts of the Akamai APIs
+type Server struct {
+	*httptest.Server
+
+	// ActivationDelay is how long PAPI activations stay PENDING before being ACTIVE
+	ActivationDelay time.Duration
+
+	// PropagationDelay is how long GTM changes stay PENDING before being COMPLETE
+	PropagationDelay time.Duration
+
+	config   edgegrid.Config
+	verifier *edgegrid.Verifier
+
+	mu     sync.Mutex
+	lastID int
+	papi   papiState
+	dns    dnsState
+	gtm    gtmState
+}
+
+// NewServer starts a new Server, with a contract and a group and no resources.
+// It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		papi: newPapiState(),
+		dns:  newDNSState(),
+		gtm:  newGTMState(),
+	}
+	s.papi.addGroup(GroupID, "Test Group", ContractID)
+
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	s.config = edgegrid.Config{
+		Host:         s.URL,
+		ClientToken:  "akab-client-token-xxx-xxxxxxxxxxxxxxxx",
+		AccessToken:  "akab-access-token-xxx-xxxxxxxxxxxxxxxx",
+		ClientSecret: "edgegridtest-client-secret-xxxxxxxxxxxxxxx=",
+		MaxBody:      131072,
+	}
+	s.verifier = &edgegrid.Verifier{Config: s.config, Nonces: edgegrid.NewMemoryNonceCache()}
+	return s
+}
+
+// Config returns the credentials accepted by the Server
+func (s *Server) Config() edgegrid.Config {
+	return s.config
+}
+
+// Session returns a new Session talking to the Server
+func (s *Server) Session() *client.Session {
+	session := client.NewSession(s.config)
+	session.HTTPClient = s.Client()
+	return session
+}
+
+// AddGroup adds a PAPI group in the given contracts, which are added if new
+func (s *Server) AddGroup(groupID, groupName string, contractIDs ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.papi.addGroup(groupID, groupName, contractIDs...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := s.verifier.Verify(r); err != nil {
+		problem(w, http.StatusUnauthorized, "Not authorized", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(path) >= 2 && path[0] == "papi" && path[1] == "v1":
+		s.servePapi(w, r, path[2:])
+	case len(path) >= 2 && path[0] == "config-dns" && path[1] == "v2":
+		s.serveDNS(w, r, path[2:])
+	case len(path) >= 2 && path[0] == "config-gtm" && path[1] == "v1":
+		s.serveGTM(w, r, path[2:])
+	default:
+		notFound(w, r)
+	}
+}
+
+// nextID returns a new identifier with prefix, e.g. "prp_10001"
+func (s *Server) nextID(prefix string) string {
+	s.lastID++
+	return fmt.Sprintf("%s%d", prefix, 10000+s.lastID)
+}
+
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// respond writes v as JSON with an ETag computed from the body. A GET or
+// HEAD request is answered with 304 Not Modified if it has the same ETag in
+// its If-None-Match header.
+func respond(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		problem(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+		return
+	}
+	respondTagged(w, r, status, body, etagOf(body))
+}
+
+// respondTagged writes body with the ETag tag
+func respondTagged(w http.ResponseWriter, r *http.Request, status int, body []byte, tag string) {
+	w.Header().Set("Content-Type", "application/json")
+	if tag != "" && status == http.StatusOK {
+		w.Header().Set("ETag", tag)
+		if (r.Method == http.MethodGet || r.Method == http.MethodHead) && matchesETag(r.Header.Get("If-None-Match"), tag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
+
+// respondNoContent writes an empty 204 No Content response
+func respondNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// precondition checks the If-Match header of r against the ETag of the
+// current representation of a resource, and writes a 412 Precondition
+// Failed response if it does not match
+func precondition(w http.ResponseWriter, r *http.Request, current interface{}) bool {
+	body, err := json.Marshal(current)
+	if err != nil {
+		problem(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+		return false
+	}
+	return preconditionTagged(w, r, etagOf(body))
+}
+
+// preconditionTagged checks the If-Match header of r against tag
+func preconditionTagged(w http.ResponseWriter, r *http.Request, tag string) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || matchesETag(ifMatch, tag) {
+		return true
+	}
+	problem(w, http.StatusPreconditionFailed, "Precondition Failed", "The resource has been modified since it was read")
+	return false
+}
+
+// etagOf returns a strong entity tag for body
+func etagOf(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:10]) + `"`
+}
+
+// matchesETag tells whether the If-Match or If-None-Match header value lists tag
+func matchesETag(header, tag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag || candidate == strings.Trim(tag, `"`) {
+			return true
+		}
+	}
+	return false
+}
+
+// problem writes an RFC 7807 problem details response, as the Akamai APIs do
+func problem(w http.ResponseWriter, status int, title, detail string) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"type":   fmt.Sprintf("https://problems.luna.akamaiapis.net/edgegridtest/%d", status),
+		"title":  title,
+		"status": status,
+		"detail": detail,
+	})
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func notFound(w http.ResponseWriter, r *http.Request) {
+	problem(w, http.StatusNotFound, "Not Found", fmt.Sprintf("%s %s not found", r.Method, r.URL.Path))
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	problem(w, http.StatusMethodNotAllowed, "Method Not Allowed", fmt.Sprintf("%s is not allowed on %s", r.Method, r.URL.Path))
+}
+
+// decode reads the JSON body of r into v, writing a 400 Bad Request response if it is invalid
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		problem(w, http.StatusBadRequest, "Bad Request", fmt.Sprintf("Invalid JSON body: %s", err))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+package edgegridtest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configdns-v2"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/papi-v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_RejectsUnsignedRequests(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	res, err := server.Client().Get(server.URL + "/papi/v1/groups")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestServer_PapiActivationFlow(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.ActivationDelay = 50 * time.Millisecond
+	c := papi.New(server.Session())
+
+	contracts, err := c.GetContracts()
+	require.NoError(t, err)
+	groups, err := c.GetGroups()
+	require.NoError(t, err)
+
+	property := c.NewProperty(c.NewProperties())
+	property.Contract = contracts.Contracts.Items[0]
+	property.Group = groups.Groups.Items[0]
+	property.PropertyName = "www.example.com"
+	property.ProductID = "prd_Fresca"
+	require.NoError(t, property.Save(""))
+	assert.Equal(t, 1, property.LatestVersion)
+
+	rules, err := property.GetRules("")
+	require.NoError(t, err)
+	etag := rules.Etag
+	digest, err := property.GetRulesDigest("")
+	require.NoError(t, err)
+	assert.Equal(t, `"`+etag+`"`, digest)
+
+	rules.Rule.Comments = "Updated"
+	require.NoError(t, rules.Save(""))
+	assert.NotEqual(t, etag, rules.Etag)
+
+	activation := papi.NewActivation(c.NewActivations())
+	activation.PropertyVersion = 1
+	activation.Network = papi.NetworkStaging
+	require.NoError(t, property.Activate(activation, true))
+	assert.Equal(t, papi.StatusPending, activation.Status)
+
+	time.Sleep(server.ActivationDelay)
+	_, err = activation.GetActivation(property)
+	require.NoError(t, err)
+	assert.Equal(t, papi.StatusActive, activation.Status)
+
+	require.NoError(t, property.GetProperty(""))
+	assert.Equal(t, 1, property.StagingVersion)
+
+	// An activated version is read-only, and an active property cannot be deleted
+	assert.Error(t, rules.Save(""))
+	assert.Error(t, property.Delete(""))
+
+	versions := c.NewVersions()
+	versions.PropertyID = property.PropertyID
+	version := versions.NewVersion(nil, true, "")
+	require.NoError(t, version.Save(""))
+	assert.Equal(t, 2, version.PropertyVersion)
+	assert.Equal(t, papi.StatusInactive, version.StagingStatus)
+}
+
+func TestServer_PapiClient(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	var c papi.PropertyService = papi.New(server.Session())
+
+	groups, err := c.GetGroups()
+	require.NoError(t, err)
+	property := papi.NewProperty(papi.NewProperties())
+	property.Contract = papi.NewContract(papi.NewContracts())
+	property.Contract.ContractID = ContractID
+	property.Group = groups.Groups.Items[0]
+	property.PropertyName = "www.example.com"
+	property.ProductID = "prd_Fresca"
+	require.NoError(t, c.CreateProperty(property))
+
+	rules, err := c.GetRules(property)
+	require.NoError(t, err)
+	rules.Rule.Comments = "Updated"
+	require.NoError(t, c.UpdateRules(rules))
+
+	version, err := c.CreateVersion(property, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, version.PropertyVersion)
+
+	property, err = c.GetProperty(property.PropertyID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, property.LatestVersion)
+	require.NoError(t, c.DeleteProperty(property))
+}
+
+func TestServer_DNSZones(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := dnsv2.New(server.Session())
+
+	zone := dnsv2.NewZone(dnsv2.ZoneCreate{Zone: "example.com", Type: "primary"})
+	require.NoError(t, c.SaveZone(zone, dnsv2.ZoneQueryString{Contract: "1-2AB34C"}))
+	assert.Error(t, c.SaveZone(zone, dnsv2.ZoneQueryString{Contract: "1-2AB34C"}))
+	require.NoError(t, c.SaveChangelist(zone))
+	require.NoError(t, c.SubmitChangelist(zone))
+
+	recordsets, err := c.GetRecordsets("example.com")
+	require.NoError(t, err)
+	assert.Len(t, recordsets.Recordsets, 2)
+
+	www := &dnsv2.Recordsets{Recordsets: []dnsv2.Recordset{{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1"}}}}
+	require.NoError(t, c.SaveRecordsets(www, "example.com"))
+	assert.Error(t, c.SaveRecordsets(www, "example.com"))
+
+	record, err := c.GetRecord("example.com", "www.example.com", "A")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.1"}, record.Target)
+
+	zones, err := c.ListZones()
+	require.NoError(t, err)
+	if assert.Len(t, zones.Zones, 1) {
+		assert.Equal(t, "ACTIVE", zones.Zones[0].ActivationState)
+	}
+
+	require.NoError(t, c.DeleteZone(zone, dnsv2.ZoneQueryString{}))
+	_, err = c.GetZone("example.com")
+	assert.Error(t, err)
+}
+
+func TestServer_DNSPagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := dnsv2.New(server.Session())
+
+	for _, name := range []string{"a.com", "b.com", "c.com", "d.com", "e.com"} {
+		zone := dnsv2.NewZone(dnsv2.ZoneCreate{Zone: name, Type: "secondary", Masters: []string{"192.0.2.1"}})
+		require.NoError(t, c.SaveZone(zone, dnsv2.ZoneQueryString{Contract: "1-2AB34C"}))
+	}
+	zone := dnsv2.NewZone(dnsv2.ZoneCreate{Zone: "example.com", Type: "primary"})
+	require.NoError(t, c.SaveZone(zone, dnsv2.ZoneQueryString{Contract: "1-2AB34C"}))
+	require.NoError(t, c.SaveChangelist(zone))
+	require.NoError(t, c.SubmitChangelist(zone))
+	www := &dnsv2.Recordsets{Recordsets: []dnsv2.Recordset{
+		{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.1"}},
+		{Name: "ftp.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.2"}},
+		{Name: "mail.example.com", Type: "A", TTL: 300, Rdata: []string{"192.0.2.3"}},
+	}}
+	require.NoError(t, c.SaveRecordsets(www, "example.com"))
+
+	for _, prefetch := range []bool{false, true} {
+		zones := c.IterZones(dnsv2.ZoneListQueryArgs{PageSize: 2})
+		zones.Prefetch = prefetch
+		var names []string
+		for zones.Next() {
+			names = append(names, zones.Value().Zone)
+		}
+		require.NoError(t, zones.Err())
+		assert.Equal(t, []string{"a.com", "b.com", "c.com", "d.com", "e.com", "example.com"}, names)
+		assert.Equal(t, 3, zones.Page())
+	}
+
+	recordsets := c.IterRecordsets("example.com", dnsv2.RecordsetQueryArgs{PageSize: 2})
+	count := 0
+	require.NoError(t, recordsets.ForEach(func(recordset dnsv2.Recordset) error {
+		count++
+		return nil
+	}))
+	assert.Equal(t, 5, count)
+	assert.Equal(t, 3, recordsets.Page())
+
+	recordsets = c.IterRecordsets("missing.com")
+	assert.False(t, recordsets.Next())
+	assert.Error(t, recordsets.Err())
+}
+
+func TestServer_ETags(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := server.Session()
+
+	zone := dnsv2.NewZone(dnsv2.ZoneCreate{Zone: "example.com", Type: "primary"})
+	require.NoError(t, dnsv2.New(session).SaveZone(zone, dnsv2.ZoneQueryString{Contract: "1-2AB34C"}))
+
+	req, _ := session.NewRequest("GET", "/config-dns/v2/zones/example.com", nil)
+	res, err := session.Do(req)
+	require.NoError(t, err)
+	etag := res.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req, _ = session.NewRequest("GET", "/config-dns/v2/zones/example.com", nil)
+	req.Header.Set("If-None-Match", etag)
+	res, err = session.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotModified, res.StatusCode)
+
+	req, _ = session.NewJSONRequest("PUT", "/config-dns/v2/zones/example.com", map[string]string{"zone": "example.com", "comment": "Updated"})
+	req.Header.Set("If-Match", `"stale"`)
+	res, err = session.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
+
+	req, _ = session.NewJSONRequest("PUT", "/config-dns/v2/zones/example.com", map[string]string{"zone": "example.com", "comment": "Updated"})
+	req.Header.Set("If-Match", etag)
+	res, err = session.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.NotEqual(t, etag, res.Header.Get("ETag"))
+}
+
+func TestServer_GTMDomains(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := configgtm.New(server.Session())
+
+	domain := configgtm.NewDomain("example.akadns.net", "basic")
+	created, err := c.CreateDomain(domain, map[string]string{"contractId": "1-2AB34C"})
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", created.Status.PropagationStatus)
+
+	property := configgtm.NewProperty("www")
+	property.Type = "failover"
+	_, err = c.CreateProperty(property, "example.akadns.net")
+	require.NoError(t, err)
+
+	domain, err = c.GetDomain("example.akadns.net")
+	require.NoError(t, err)
+	if assert.Len(t, domain.Properties, 1) {
+		assert.Equal(t, "failover", domain.Properties[0].Type)
+	}
+
+	status, err := c.GetDomainStatus("example.akadns.net")
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETE", status.PropagationStatus)
+
+	_, err = c.DeleteProperty(property, "example.akadns.net")
+	require.NoError(t, err)
+	properties, err := c.ListProperties("example.akadns.net")
+	require.NoError(t, err)
+	assert.Empty(t, properties)
+}
@@ -1,5 +1,6 @@
 // Package jsonhooks adds hooks that are automatically called before JSON marshaling (PreMarshalJSON) and
-// after JSON unmarshaling (PostUnmarshalJSON). It does not do so recursively.
+// after JSON unmarshaling (PostUnmarshalJSON). Marshal and Unmarshal only call the hooks of the value passed,
+// MarshalRecursive and UnmarshalRecursive also call those of the values nested in it.
 package jsonhooks
 
 import (
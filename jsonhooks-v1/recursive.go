@@ -0,0 +1,299 @@
+package jsonhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// ParentSetter is implemented by values wanting a reference to the value they
+// are nested in when unmarshaled by UnmarshalRecursive
+type ParentSetter interface {
+	SetParent(parent interface{})
+}
+
+// MarshalRecursive wraps encoding/json.Marshal like Marshal, but also calls the
+// PreMarshalJSON hooks of the values nested in v. Hooks are called top-down:
+// a value's hook is called before those of the values it holds, so it may still
+// prepare them.
+//
+// The walk follows what encoding/json encodes: pointers, interfaces, slices,
+// arrays, map values in key order, and the exported struct fields not tagged
+// "-", in declaration order. Unexported fields, such as parent pointers, are not
+// followed, and a value reached twice, such as through a cycle of pointers, is
+// only visited once. The hooks of embedded structs are promoted to, and so only
+// called through, the struct embedding them. Values whose type cannot have
+// hooks are skipped, and never written to.
+func MarshalRecursive(v interface{}) ([]byte, error) {
+	w := &walker{visited: map[visit]bool{}}
+	if err := w.walk(reflect.ValueOf(v), nil, false); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalRecursive wraps encoding/json.Unmarshal like Unmarshal, but also
+// calls the PostUnmarshalJSON hooks of the values nested in v, walked as by
+// MarshalRecursive. Hooks are called bottom-up: a value's hook is called after
+// those of the values it holds, so it sees them complete.
+//
+// Before its nested values are walked, each value implementing ParentSetter is
+// passed its parent: the nearest value holding it which implements one of the
+// hooks or ParentSetter. v itself has no parent.
+func UnmarshalRecursive(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	if err != nil {
+		return err
+	}
+
+	w := &walker{post: true, visited: map[visit]bool{}}
+	return w.walk(reflect.ValueOf(v), nil, false)
+}
+
+// visit identifies a value already walked. The type tells a struct from its
+// first field, which shares its address.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// walker calls the hooks of a value and of the values nested in it
+type walker struct {
+	post    bool
+	visited map[visit]bool
+}
+
+// walk walks v, held by parent. embedded is true for an embedded struct field,
+// whose hooks are not called.
+func (w *walker) walk(v reflect.Value, parent interface{}, embedded bool) error {
+	if !v.IsValid() || !mayHook(v.Type()) || w.seen(v) {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return w.walk(v.Elem(), parent, embedded)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		value, copied, err := w.walkHeld(v.Elem(), parent)
+		if err != nil || !copied || !v.CanSet() {
+			return err
+		}
+		v.Set(value)
+		return nil
+	}
+
+	var node interface{}
+	if !embedded && reflect.PtrTo(v.Type()).NumMethod() > 0 {
+		if v.CanAddr() {
+			node = v.Addr().Interface()
+		} else if v.CanInterface() {
+			node = v.Interface()
+		}
+	}
+
+	if w.post {
+		if setter, ok := node.(ParentSetter); ok && parent != nil {
+			setter.SetParent(parent)
+		}
+	} else if marshaler, ok := node.(PreJSONMarshaler); ok {
+		if err := marshaler.PreMarshalJSON(); err != nil {
+			return err
+		}
+	}
+
+	if hooked(node) {
+		parent = node
+	}
+	if err := w.walkNested(v, parent); err != nil {
+		return err
+	}
+
+	if unmarshaler, ok := node.(PostJSONUnmarshaler); ok && w.post {
+		if err := unmarshaler.PostUnmarshalJSON(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkNested walks the values held by v
+func (w *walker) walkNested(v reflect.Value, parent interface{}) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" || field.Tag.Get("json") == "-" {
+				continue
+			}
+			if err := w.walk(v.Field(i), parent, field.Anonymous); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.walk(v.Index(i), parent, false); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return lessKey(keys[i], keys[j])
+		})
+		for _, key := range keys {
+			elem := v.MapIndex(key)
+			if elem.Kind() == reflect.Interface {
+				if elem.IsNil() {
+					continue
+				}
+				elem = elem.Elem()
+			}
+			value, copied, err := w.walkHeld(elem, parent)
+			if err != nil {
+				return err
+			}
+			if copied {
+				v.SetMapIndex(key, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// walkHeld walks v, held by an interface or a map and so not addressable.
+// Pointers, maps and slices are walked in place. Other values which may have
+// hooks are walked in a copy, returned to be stored in place of v, as the hooks
+// need an addressable value; values which cannot have hooks are left untouched,
+// so that a value shared between goroutines is only written to for its hooks.
+func (w *walker) walkHeld(v reflect.Value, parent interface{}) (reflect.Value, bool, error) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		return v, false, w.walk(v, parent, false)
+	}
+	if !mayHook(v.Type()) {
+		return v, false, nil
+	}
+
+	value := reflect.New(v.Type()).Elem()
+	value.Set(v)
+	if err := w.walk(value, parent, false); err != nil {
+		return v, false, err
+	}
+	return value, true, nil
+}
+
+// seen records v as visited, returning true if it already was. Only the values
+// which may be reached more than once are recorded, and walk only checks those
+// which may have hooks.
+func (w *walker) seen(v reflect.Value) bool {
+	var key visit
+	switch {
+	case v.Kind() == reflect.Map:
+		if v.IsNil() {
+			return false
+		}
+		key = visit{v.Pointer(), v.Type()}
+	case v.CanAddr():
+		key = visit{v.UnsafeAddr(), v.Type()}
+	default:
+		return false
+	}
+
+	if w.visited[key] {
+		return true
+	}
+	w.visited[key] = true
+	return false
+}
+
+var (
+	preJSONMarshalerType    = reflect.TypeOf((*PreJSONMarshaler)(nil)).Elem()
+	postJSONUnmarshalerType = reflect.TypeOf((*PostJSONUnmarshaler)(nil)).Elem()
+	parentSetterType        = reflect.TypeOf((*ParentSetter)(nil)).Elem()
+
+	// mayHookCache caches the result of mayHook by type
+	mayHookCache sync.Map
+)
+
+// mayHook returns true if a value of type t may implement, or hold a value
+// which implements, any of the hooks or ParentSetter. Interfaces may hold any
+// value, their dynamic value is checked when walked.
+func mayHook(t reflect.Type) bool {
+	if cached, ok := mayHookCache.Load(t); ok {
+		return cached.(bool)
+	}
+
+	result := typeMayHook(t, map[reflect.Type]bool{})
+	mayHookCache.Store(t, result)
+	return result
+}
+
+// typeMayHook computes mayHook, ignoring the types in walking, which are being
+// checked further up a recursive type
+func typeMayHook(t reflect.Type, walking map[reflect.Type]bool) bool {
+	if walking[t] {
+		return false
+	}
+	walking[t] = true
+
+	for _, hook := range []reflect.Type{preJSONMarshalerType, postJSONUnmarshalerType, parentSetterType} {
+		if t.Implements(hook) || reflect.PtrTo(t).Implements(hook) {
+			return true
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return typeMayHook(t.Elem(), walking)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" || field.Tag.Get("json") == "-" {
+				continue
+			}
+			if typeMayHook(field.Type, walking) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hooked returns true if v implements any of the hooks or ParentSetter
+func hooked(v interface{}) bool {
+	switch v.(type) {
+	case PreJSONMarshaler, PostJSONUnmarshaler, ParentSetter:
+		return true
+	}
+	return false
+}
+
+// lessKey orders map keys, numerically for integers
+func lessKey(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	}
+	return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+}
@@ -0,0 +1,192 @@
+package jsonhooks
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// calls records the hooks called, in order
+var calls []string
+
+type Node struct {
+	Name     string           `json:"name"`
+	Children []*Node          `json:"children,omitempty"`
+	Named    map[string]*Node `json:"named,omitempty"`
+	Next     *Node            `json:"-"`
+
+	parent interface{}
+}
+
+func (node *Node) PreMarshalJSON() error {
+	calls = append(calls, "pre "+node.Name)
+	return nil
+}
+
+func (node *Node) PostUnmarshalJSON() error {
+	calls = append(calls, "post "+node.Name)
+	return nil
+}
+
+func (node *Node) SetParent(parent interface{}) {
+	node.parent = parent
+}
+
+type Tree struct {
+	Node
+}
+
+func (tree *Tree) PostUnmarshalJSON() error {
+	calls = append(calls, "post tree")
+	return nil
+}
+
+func (tree *Tree) SetParent(parent interface{}) {
+	calls = append(calls, "parent tree")
+}
+
+func TestUnmarshalRecursive(t *testing.T) {
+	calls = nil
+	data := []byte(`{
+		"name": "root",
+		"children": [
+			{"name": "a", "children": [{"name": "a1"}]},
+			{"name": "b"}
+		],
+		"named": {"z": {"name": "z"}, "y": {"name": "y"}}
+	}`)
+
+	tree := &Tree{}
+	require.NoError(t, UnmarshalRecursive(data, tree))
+
+	// Children first, slices in order, maps by key, and the hooks of the
+	// embedded Node only called through the Tree, which has no parent
+	assert.Equal(t, []string{"post a1", "post a", "post b", "post y", "post z", "post tree"}, calls)
+
+	a := tree.Children[0]
+	assert.Equal(t, tree, a.parent)
+	assert.Equal(t, a, a.Children[0].parent)
+	assert.Equal(t, tree, tree.Named["y"].parent)
+}
+
+func TestMarshalRecursive(t *testing.T) {
+	calls = nil
+	leaf := &Node{Name: "leaf"}
+	root := &Node{
+		Name:     "root",
+		Children: []*Node{{Name: "a", Children: []*Node{leaf}}, leaf},
+		Named:    map[string]*Node{"b": {Name: "b"}},
+		Next:     &Node{Name: "skipped"},
+	}
+
+	data, err := MarshalRecursive(root)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"root","children":[{"name":"a","children":[{"name":"leaf"}]},{"name":"leaf"}],"named":{"b":{"name":"b"}}}`, string(data))
+
+	// Parents first, the leaf reached twice only visited once
+	assert.Equal(t, []string{"pre root", "pre a", "pre leaf", "pre b"}, calls)
+}
+
+func TestMarshalRecursive_Values(t *testing.T) {
+	type holder struct {
+		Hooked   WithHooks            `json:"hooked"`
+		InMap    map[string]WithHooks `json:"inMap"`
+		InIface  interface{}          `json:"inIface"`
+		Unhooked MixedTypes           `json:"unhooked"`
+	}
+
+	v := &holder{
+		Hooked:   WithHooks{S: "field"},
+		InMap:    map[string]WithHooks{"key": {S: "map"}},
+		InIface:  &WithHooks{S: "iface"},
+		Unhooked: MixedTypes{S: "none"},
+	}
+	_, err := MarshalRecursive(v)
+	require.NoError(t, err)
+
+	assert.Equal(t, "FIELD", v.Hooked.S)
+	assert.Equal(t, "MAP", v.InMap["key"].S)
+	assert.Equal(t, "IFACE", v.InIface.(*WithHooks).S)
+	assert.Equal(t, "none", v.Unhooked.S)
+}
+
+func TestWalk_Cycles(t *testing.T) {
+	calls = nil
+	a := &Node{Name: "a"}
+	b := &Node{Name: "b"}
+	a.Children = []*Node{b}
+	b.Children = []*Node{a}
+	b.Named = map[string]*Node{"self": b}
+
+	w := &walker{post: true, visited: map[visit]bool{}}
+	require.NoError(t, w.walk(reflect.ValueOf(a), nil, false))
+	assert.Equal(t, []string{"post b", "post a"}, calls)
+
+	m := map[string]interface{}{"list": []interface{}{"x"}}
+	m["self"] = m
+	m["list"].([]interface{})[0] = m["list"]
+	w = &walker{visited: map[visit]bool{}}
+	assert.NoError(t, w.walk(reflect.ValueOf(m), nil, false))
+}
+
+func TestMarshalRecursive_Concurrent(t *testing.T) {
+	type behavior struct {
+		Name    string                 `json:"name"`
+		Options map[string]interface{} `json:"options"`
+	}
+	shared := &struct {
+		Behaviors []behavior             `json:"behaviors"`
+		Options   map[string]interface{} `json:"options"`
+	}{
+		Behaviors: []behavior{{
+			Name: "origin",
+			Options: map[string]interface{}{
+				"hostname": "origin.example.com",
+				"ports":    []interface{}{80.0, 443.0},
+				"nested":   map[string]interface{}{"enabled": true, "struct": MixedTypes{S: "value"}},
+			},
+		}},
+		Options: map[string]interface{}{"is_secure": false, "struct": MixedTypes{S: "value"}},
+	}
+
+	// Values without hooks are not written to, run with go test -race
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := MarshalRecursive(shared)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMayHook(t *testing.T) {
+	assert.True(t, mayHook(reflect.TypeOf(WithHooks{})))
+	assert.True(t, mayHook(reflect.TypeOf(map[string][]*Node{})))
+	assert.True(t, mayHook(reflect.TypeOf([]interface{}{})))
+	assert.False(t, mayHook(reflect.TypeOf(MixedTypes{})))
+	assert.False(t, mayHook(reflect.TypeOf(map[string][]*MixedTypes{})))
+}
+
+type failingHook struct {
+	Name string `json:"name"`
+}
+
+func (hook *failingHook) PostUnmarshalJSON() error {
+	return errors.New("failed " + hook.Name)
+}
+
+func TestUnmarshalRecursive_Error(t *testing.T) {
+	var v struct {
+		Items []*failingHook `json:"items"`
+	}
+
+	err := UnmarshalRecursive([]byte(`{"items":[{"name":"first"},{"name":"second"}]}`), &v)
+	assert.EqualError(t, err, "failed first")
+}
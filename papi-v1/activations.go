@@ -1,6 +1,7 @@
 package papi
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -35,8 +36,8 @@ func NewActivations() *Activations {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listactivations
 // Endpoint: GET /papi/v1/properties/{propertyId}/activations/{?contractId,groupId}
 func (activations *Activations) GetActivations(property *Property) error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(activations, property)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf("/papi/v1/properties/%s/activations?contractId=%s&groupId=%s",
 			property.PropertyID,
@@ -52,7 +53,7 @@ func (activations *Activations) GetActivations(property *Property) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 
 	if err != nil {
 		return err
@@ -64,7 +65,7 @@ func (activations *Activations) GetActivations(property *Property) error {
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, activations); err != nil {
+	if err = bodyJSON(res, activations); err != nil {
 		return err
 	}
 
@@ -144,6 +145,7 @@ func NewActivation(parent *Activations) *Activation {
 	activation := &Activation{parent: parent}
 	activation.Init()
 
+	inherit(activation, parent)
 	return activation
 }
 
@@ -157,8 +159,12 @@ func (activation *Activation) Init() {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getanactivation
 // Endpoint: GET /papi/v1/properties/{propertyId}/activations/{activationId}{?contractId,groupId}
 func (activation *Activation) GetActivation(property *Property) (time.Duration, error) {
-	req, err := client.NewRequest(
-		Config,
+	return activation.getActivation(sessionOf(activation, property), property)
+}
+
+// getActivation populates the Activation like GetActivation, using session
+func (activation *Activation) getActivation(session *client.Session, property *Property) (time.Duration, error) {
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/activations/%s?contractId=%s&groupId=%s",
@@ -176,7 +182,7 @@ func (activation *Activation) GetActivation(property *Property) (time.Duration,
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return 0, err
 	}
@@ -188,7 +194,7 @@ func (activation *Activation) GetActivation(property *Property) (time.Duration,
 	}
 
 	activations := NewActivations()
-	if err := client.BodyJSON(res, activations); err != nil {
+	if err := bodyJSON(res, activations); err != nil {
 		return 0, err
 	}
 
@@ -223,14 +229,14 @@ func (activation *Activation) GetActivation(property *Property) (time.Duration,
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#activateaproperty
 // Endpoint: POST /papi/v1/properties/{propertyId}/activations/{?contractId,groupId}
 func (activation *Activation) Save(property *Property, acknowledgeWarnings bool) error {
+	session := sessionOf(activation, property)
 	if activation.ComplianceRecord == nil {
 		activation.ComplianceRecord = &ActivationComplianceRecord{
 			NoncomplianceReason: "NO_PRODUCTION_TRAFFIC",
 		}
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/activations?contractId=%s&groupId=%s",
@@ -247,7 +253,7 @@ func (activation *Activation) Save(property *Property, acknowledgeWarnings bool)
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 
 	if err != nil {
 		return err
@@ -296,12 +302,11 @@ func (activation *Activation) Save(property *Property, acknowledgeWarnings bool)
 	}
 
 	var location client.JSONBody
-	if err = client.BodyJSON(res, &location); err != nil {
+	if err = bodyJSON(res, &location); err != nil {
 		return err
 	}
 
-	req, err = client.NewRequest(
-		Config,
+	req, err = session.NewRequest(
 		"GET",
 		location["activationLink"].(string),
 		nil,
@@ -313,7 +318,7 @@ func (activation *Activation) Save(property *Property, acknowledgeWarnings bool)
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err = client.Do(Config, req)
+	res, err = session.Do(req)
 
 	if err != nil {
 		return err
@@ -322,7 +327,7 @@ func (activation *Activation) Save(property *Property, acknowledgeWarnings bool)
 	edge.PrintHttpResponse(res, true)
 
 	activations := NewActivations()
-	if err := client.BodyJSON(res, activations); err != nil {
+	if err := bodyJSON(res, activations); err != nil {
 		return err
 	}
 
@@ -367,14 +372,25 @@ func (activation *Activation) Save(property *Property, acknowledgeWarnings bool)
 //		// Activation succeeded
 //	}
 func (activation *Activation) PollStatus(property *Property) bool {
+	return activation.PollStatusWithContext(sessionOf(activation, property).Context(), property)
+}
+
+// PollStatusWithContext polls like PollStatus, with ctx. Polling stops when
+// ctx is done, sending false to the Activation.StatusChange channel.
+func (activation *Activation) PollStatusWithContext(ctx context.Context, property *Property) bool {
+	session := sessionOf(activation, property).WithContext(ctx)
+
 	currentStatus := activation.Status
 	var retry time.Duration = 0
 
 	for currentStatus != StatusActive {
-		time.Sleep(retry)
+		if err := client.Sleep(ctx, retry); err != nil {
+			activation.StatusChange <- false
+			return false
+		}
 
 		var err error
-		retry, err = activation.GetActivation(property)
+		retry, err = activation.getActivation(session, property)
 
 		if err != nil {
 			activation.StatusChange <- false
@@ -404,8 +420,8 @@ func (activation *Activation) PollStatus(property *Property) bool {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#cancelapendingactivation
 // Endpoint: DELETE /papi/v1/properties/{propertyId}/activations/{activationId}{?contractId,groupId}
 func (activation *Activation) Cancel(property *Property) error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(activation, property)
+	req, err := session.NewRequest(
 		"DELETE",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/activations?contractId=%s&groupId=%s",
@@ -422,7 +438,7 @@ func (activation *Activation) Cancel(property *Property) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 
 	if err != nil {
 		return err
@@ -435,7 +451,7 @@ func (activation *Activation) Cancel(property *Property) error {
 	}
 
 	newActivations := NewActivations()
-	if err := client.BodyJSON(res, newActivations); err != nil {
+	if err := bodyJSON(res, newActivations); err != nil {
 		return err
 	}
 
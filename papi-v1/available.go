@@ -37,8 +37,8 @@ func NewAvailableCriteria() *AvailableCriteria {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listavailablecriteria
 // Endpoint: GET /papi/v1/properties/{propertyId}/versions/{propertyVersion}/available-criteria{?contractId,groupId}
 func (availableCriteria *AvailableCriteria) GetAvailableCriteria(property *Property) error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(availableCriteria, property)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/available-criteria?contractId=%s&groupId=%s",
@@ -55,7 +55,7 @@ func (availableCriteria *AvailableCriteria) GetAvailableCriteria(property *Prope
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -66,7 +66,7 @@ func (availableCriteria *AvailableCriteria) GetAvailableCriteria(property *Prope
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, availableCriteria); err != nil {
+	if err = bodyJSON(res, availableCriteria); err != nil {
 		return err
 	}
 
@@ -93,29 +93,14 @@ func NewAvailableBehaviors() *AvailableBehaviors {
 	return availableBehaviors
 }
 
-// PostUnmarshalJSON is called after JSON unmarshaling into EdgeHostnames
-//
-// See: jsonhooks-v1/jsonhooks.Unmarshal()
-func (availableBehaviors *AvailableBehaviors) PostUnmarshalJSON() error {
-	availableBehaviors.Init()
-
-	for key := range availableBehaviors.Behaviors.Items {
-		availableBehaviors.Behaviors.Items[key].parent = availableBehaviors
-	}
-
-	availableBehaviors.Complete <- true
-
-	return nil
-}
-
 // GetAvailableBehaviors retrieves available behaviors for a given property
 //
 // See: Property.GetAvailableBehaviors
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listavailablebehaviors
 // Endpoint: GET /papi/v1/properties/{propertyId}/versions/{propertyVersion}/available-behaviors{?contractId,groupId}
 func (availableBehaviors *AvailableBehaviors) GetAvailableBehaviors(property *Property) error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(availableBehaviors, property)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/available-behaviors?contractId=%s&groupId=%s",
@@ -132,7 +117,7 @@ func (availableBehaviors *AvailableBehaviors) GetAvailableBehaviors(property *Pr
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -143,7 +128,7 @@ func (availableBehaviors *AvailableBehaviors) GetAvailableBehaviors(property *Pr
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, availableBehaviors); err != nil {
+	if err = bodyJSON(res, availableBehaviors); err != nil {
 		return err
 	}
 
@@ -163,13 +148,22 @@ func NewAvailableBehavior(parent *AvailableBehaviors) *AvailableBehavior {
 	availableBehavior := &AvailableBehavior{parent: parent}
 	availableBehavior.Init()
 
+	inherit(availableBehavior, parent)
 	return availableBehavior
 }
 
+// SetParent sets the AvailableBehaviors the AvailableBehavior belongs to
+func (availableBehavior *AvailableBehavior) SetParent(parent interface{}) {
+	availableBehavior.Resource.SetParent(parent)
+	if availableBehaviors, ok := parent.(*AvailableBehaviors); ok {
+		availableBehavior.parent = availableBehaviors
+	}
+}
+
 // GetSchema retrieves the JSON schema for an available behavior
 func (behavior *AvailableBehavior) GetSchema() (*gojsonschema.Schema, error) {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(behavior)
+	req, err := session.NewRequest(
 		"GET",
 		behavior.SchemaLink,
 		nil,
@@ -178,7 +172,7 @@ func (behavior *AvailableBehavior) GetSchema() (*gojsonschema.Schema, error) {
 		return nil, err
 	}
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -24,14 +24,15 @@ func NewClientSettings() *ClientSettings {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getclientsettings
 // Endpoint: GET /papi/v1/client-settings
 func (clientSettings *ClientSettings) GetClientSettings() error {
-	req, err := client.NewRequest(Config, "GET", "/papi/v1/client-settings", nil)
+	session := sessionOf(clientSettings)
+	req, err := session.NewRequest("GET", "/papi/v1/client-settings", nil)
 	if err != nil {
 		return err
 	}
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -42,7 +43,7 @@ func (clientSettings *ClientSettings) GetClientSettings() error {
 		return client.NewAPIError(res)
 	}
 
-	if err := client.BodyJSON(res, clientSettings); err != nil {
+	if err := bodyJSON(res, clientSettings); err != nil {
 		return err
 	}
 
@@ -54,8 +55,8 @@ func (clientSettings *ClientSettings) GetClientSettings() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#updateclientsettings
 // Endpoint: PUT /papi/v1/client-settings
 func (clientSettings *ClientSettings) Save() error {
-	req, err := client.NewJSONRequest(
-		Config,
+	session := sessionOf(clientSettings)
+	req, err := session.NewJSONRequest(
 		"PUT",
 		"/papi/v1/client-settings",
 		clientSettings,
@@ -66,7 +67,7 @@ func (clientSettings *ClientSettings) Save() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -78,7 +79,7 @@ func (clientSettings *ClientSettings) Save() error {
 	}
 
 	newClientSettings := NewClientSettings()
-	if err := client.BodyJSON(res, newClientSettings); err != nil {
+	if err := bodyJSON(res, newClientSettings); err != nil {
 		return err
 	}
 
@@ -1,12 +1,10 @@
 package papi
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
-	"github.com/patrickmn/go-cache"
 )
 
 // Contracts represents a collection of property manager contracts
@@ -25,70 +23,44 @@ func NewContracts() *Contracts {
 	return contracts
 }
 
-// PostUnmarshalJSON is called after JSON unmarshaling into EdgeHostnames
-//
-// See: jsonhooks-v1/jsonhooks.Unmarshal()
-func (contracts *Contracts) PostUnmarshalJSON() error {
-	contracts.Init()
-
-	for key, contract := range contracts.Contracts.Items {
-		contracts.Contracts.Items[key].parent = contracts
-
-		if err := contract.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-	contracts.Complete <- true
-
-	return nil
-}
-
 // GetContracts populates Contracts with contract data
 //
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listcontracts
 // Endpoint: GET /papi/v1/contracts
 func (contracts *Contracts) GetContracts(correlationid string) error {
+	session := sessionOf(contracts)
 
-	cachecontracts, found := Profilecache.Get("contracts")
-	if found {
-		json.Unmarshal(cachecontracts.([]byte), contracts)
-		return nil
-	} else {
-
-		req, err := client.NewRequest(
-			Config,
-			"GET",
-			"/papi/v1/contracts",
-			nil,
-		)
-		if err != nil {
-			return err
-		}
+	req, err := session.NewRequest(
+		"GET",
+		"/papi/v1/contracts",
+		nil,
+	)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpRequestCorrelation(req, true, correlationid)
+	req = client.CacheFor(req, CacheTTL)
+	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-		res, err := client.Do(Config, req)
-		if err != nil {
-			return err
-		}
+	res, err := session.Do(req)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpResponseCorrelation(res, true, correlationid)
+	edge.PrintHttpResponseCorrelation(res, true, correlationid)
 
-		if client.IsError(res) {
-			return client.NewAPIError(res)
-		}
+	if client.IsError(res) {
+		return client.NewAPIError(res)
+	}
 
-		if err = client.BodyJSON(res, contracts); err != nil {
-			return err
-		}
+	if err = bodyJSON(res, contracts); err != nil {
+		return err
+	}
 
-		if err != nil {
-			return err
-		}
-		byt, _ := json.Marshal(contracts)
-		Profilecache.Set("contracts", byt, cache.DefaultExpiration)
-		return nil
+	if err != nil {
+		return err
 	}
+	return nil
 }
 
 // FindContract finds a specific contract by ID
@@ -123,12 +95,21 @@ func NewContract(parent *Contracts) *Contract {
 		parent: parent,
 	}
 	contract.Init()
+	inherit(contract, parent)
 	return contract
 }
 
+// SetParent sets the Contracts the Contract belongs to
+func (contract *Contract) SetParent(parent interface{}) {
+	contract.Resource.SetParent(parent)
+	if contracts, ok := parent.(*Contracts); ok {
+		contract.parent = contracts
+	}
+}
+
 // GetContract populates a Contract
 func (contract *Contract) GetContract() error {
-	contracts, err := GetContracts()
+	contracts, err := clientOf(contract).GetContracts()
 	if err != nil {
 		return err
 	}
@@ -147,8 +128,8 @@ func (contract *Contract) GetContract() error {
 
 // GetProducts gets products associated with a contract
 func (contract *Contract) GetProducts() (*Products, error) {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(contract)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/products?contractId=%s",
@@ -162,7 +143,7 @@ func (contract *Contract) GetProducts() (*Products, error) {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +155,7 @@ func (contract *Contract) GetProducts() (*Products, error) {
 	}
 
 	products := NewProducts()
-	if err = client.BodyJSON(res, products); err != nil {
+	if err = bodyJSON(res, products); err != nil {
 		return nil, err
 	}
 
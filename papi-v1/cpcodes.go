@@ -1,7 +1,6 @@
 package papi
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"strconv"
@@ -10,7 +9,6 @@ import (
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
-	"github.com/patrickmn/go-cache"
 )
 
 // CpCodes represents a collection of CP Codes
@@ -44,9 +42,11 @@ func (cpcodes *CpCodes) PostUnmarshalJSON() error {
 	cpcodes.Init()
 
 	cpcodes.Contract = NewContract(NewContracts())
+	inherit(cpcodes.Contract, cpcodes)
 	cpcodes.Contract.ContractID = cpcodes.ContractID
 
 	cpcodes.Group = NewGroup(NewGroups())
+	inherit(cpcodes.Group, cpcodes)
 	cpcodes.Group.GroupID = cpcodes.GroupID
 
 	go cpcodes.Group.GetGroup()
@@ -58,14 +58,6 @@ func (cpcodes *CpCodes) PostUnmarshalJSON() error {
 		cpcodes.Complete <- (contractComplete && groupComplete)
 	})(cpcodes)
 
-	for key, cpcode := range cpcodes.CpCodes.Items {
-		cpcodes.CpCodes.Items[key].parent = cpcodes
-
-		if err := cpcode.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
 	return nil
 }
 
@@ -74,50 +66,44 @@ func (cpcodes *CpCodes) PostUnmarshalJSON() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listcpcodes
 // Endpoint: GET /papi/v1/cpcodes/{?contractId,groupId}
 func (cpcodes *CpCodes) GetCpCodes(correlationid string) error {
-	cachecpcodes, found := Profilecache.Get("cpcodes")
-	if found {
-		json.Unmarshal(cachecpcodes.([]byte), cpcodes)
-		return nil
-	} else {
-		if cpcodes.Contract == nil {
-			cpcodes.Contract = NewContract(NewContracts())
-			cpcodes.Contract.ContractID = cpcodes.Group.ContractIDs[0]
-		}
+	session := sessionOf(cpcodes, cpcodes.Group, cpcodes.Contract)
+	if cpcodes.Contract == nil {
+		cpcodes.Contract = NewContract(NewContracts())
+		cpcodes.Contract.ContractID = cpcodes.Group.ContractIDs[0]
+	}
 
-		req, err := client.NewRequest(
-			Config,
-			"GET",
-			fmt.Sprintf(
-				"/papi/v1/cpcodes?groupId=%s&contractId=%s",
-				cpcodes.Group.GroupID,
-				cpcodes.Contract.ContractID,
-			),
-			nil,
-		)
-		if err != nil {
-			return err
-		}
+	req, err := session.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"/papi/v1/cpcodes?groupId=%s&contractId=%s",
+			cpcodes.Group.GroupID,
+			cpcodes.Contract.ContractID,
+		),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpRequestCorrelation(req, true, correlationid)
+	req = client.CacheFor(req, CacheTTL)
+	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-		res, err := client.Do(Config, req)
-		if err != nil {
-			return err
-		}
+	res, err := session.Do(req)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpResponseCorrelation(res, true, correlationid)
+	edge.PrintHttpResponseCorrelation(res, true, correlationid)
 
-		if client.IsError(res) {
-			return client.NewAPIError(res)
-		}
+	if client.IsError(res) {
+		return client.NewAPIError(res)
+	}
 
-		if err = client.BodyJSON(res, cpcodes); err != nil {
-			return err
-		}
-		byt, _ := json.Marshal(cpcodes)
-		Profilecache.Set("cpcodes", byt, cache.DefaultExpiration)
-		return nil
+	if err = bodyJSON(res, cpcodes); err != nil {
+		return err
 	}
+
+	return nil
 }
 
 func (cpcodes *CpCodes) FindCpCode(nameOrId string, correlationid string) (*CpCode, error) {
@@ -177,16 +163,25 @@ type CpCode struct {
 func NewCpCode(parent *CpCodes) *CpCode {
 	cpcode := &CpCode{parent: parent}
 	cpcode.Init()
+	inherit(cpcode, parent)
 	return cpcode
 }
 
+// SetParent sets the CpCodes the CpCode belongs to
+func (cpcode *CpCode) SetParent(parent interface{}) {
+	cpcode.Resource.SetParent(parent)
+	if cpcodes, ok := parent.(*CpCodes); ok {
+		cpcode.parent = cpcodes
+	}
+}
+
 // GetCpCode populates the *CpCode with it's data
 //
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getacpcode
 // Endpoint: GET /papi/v1/cpcodes/{cpcodeId}{?contractId,groupId}
 func (cpcode *CpCode) GetCpCode() error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(cpcode)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/cpcodes/%s?contractId=%s&groupId=%s",
@@ -202,7 +197,7 @@ func (cpcode *CpCode) GetCpCode() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 
 	if err != nil {
 		return err
@@ -215,7 +210,7 @@ func (cpcode *CpCode) GetCpCode() error {
 	}
 
 	newCpcodes := NewCpCodes(nil, nil)
-	if err = client.BodyJSON(res, newCpcodes); err != nil {
+	if err = bodyJSON(res, newCpcodes); err != nil {
 		return err
 	}
 	if len(newCpcodes.CpCodes.Items) == 0 {
@@ -251,8 +246,8 @@ func (cpcode *CpCode) ID() int {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#createanewcpcode
 // Endpoint: POST /papi/v1/cpcodes/{?contractId,groupId}
 func (cpcode *CpCode) Save(correlationid string) error {
-	req, err := client.NewJSONRequest(
-		Config,
+	session := sessionOf(cpcode)
+	req, err := session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf(
 			"/papi/v1/cpcodes?contractId=%s&groupId=%s",
@@ -267,7 +262,7 @@ func (cpcode *CpCode) Save(correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -279,12 +274,11 @@ func (cpcode *CpCode) Save(correlationid string) error {
 	}
 
 	var location client.JSONBody
-	if err = client.BodyJSON(res, &location); err != nil {
+	if err = bodyJSON(res, &location); err != nil {
 		return err
 	}
 
-	req, err = client.NewRequest(
-		Config,
+	req, err = session.NewRequest(
 		"GET",
 		location["cpcodeLink"].(string),
 		nil,
@@ -295,7 +289,7 @@ func (cpcode *CpCode) Save(correlationid string) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err = client.Do(Config, req)
+	res, err = session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -311,7 +305,7 @@ func (cpcode *CpCode) Save(correlationid string) error {
 		return err
 	}
 
-	if err = client.BodyJSON(res, cpcodes); err != nil {
+	if err = bodyJSON(res, cpcodes); err != nil {
 		return err
 	}
 
@@ -31,14 +31,6 @@ func NewCustomBehaviors() *CustomBehaviors {
 func (behaviors *CustomBehaviors) PostUnmarshalJSON() error {
 	behaviors.Init()
 
-	for key, behavior := range behaviors.CustomBehaviors.Items {
-		behaviors.CustomBehaviors.Items[key].parent = behaviors
-
-		if err := behavior.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
 	return nil
 }
 
@@ -47,8 +39,8 @@ func (behaviors *CustomBehaviors) PostUnmarshalJSON() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getcustombehaviors
 // Endpoint: GET /papi/v1/custom-behaviors
 func (behaviors *CustomBehaviors) GetCustomBehaviors() error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(behaviors)
+	req, err := session.NewRequest(
 		"GET",
 		"/papi/v1/custom-behaviors",
 		nil,
@@ -59,7 +51,7 @@ func (behaviors *CustomBehaviors) GetCustomBehaviors() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -70,7 +62,7 @@ func (behaviors *CustomBehaviors) GetCustomBehaviors() error {
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, behaviors); err != nil {
+	if err = bodyJSON(res, behaviors); err != nil {
 		return err
 	}
 
@@ -111,8 +103,8 @@ type CustomBehavior struct {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getcustombehavior
 // Endpoint: GET /papi/v1/custom-behaviors/{behaviorId}
 func (behavior *CustomBehavior) GetCustomBehavior() error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(behavior)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/custom-behaviors/%s",
@@ -126,7 +118,7 @@ func (behavior *CustomBehavior) GetCustomBehavior() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 
 	if err != nil {
 		return err
@@ -139,7 +131,7 @@ func (behavior *CustomBehavior) GetCustomBehavior() error {
 	}
 
 	newCustomBehaviors := NewCustomBehaviors()
-	if err = client.BodyJSON(res, newCustomBehaviors); err != nil {
+	if err = bodyJSON(res, newCustomBehaviors); err != nil {
 		return err
 	}
 	if len(newCustomBehaviors.CustomBehaviors.Items) == 0 {
@@ -161,5 +153,15 @@ func (behavior *CustomBehavior) GetCustomBehavior() error {
 
 // NewCustomBehavior creates a new *CustomBehavior
 func NewCustomBehavior(behaviors *CustomBehaviors) *CustomBehavior {
-	return &CustomBehavior{parent: behaviors}
+	behavior := &CustomBehavior{parent: behaviors}
+	inherit(behavior, behaviors)
+	return behavior
+}
+
+// SetParent sets the CustomBehaviors the CustomBehavior belongs to
+func (behavior *CustomBehavior) SetParent(parent interface{}) {
+	behavior.Resource.SetParent(parent)
+	if behaviors, ok := parent.(*CustomBehaviors); ok {
+		behavior.parent = behaviors
+	}
 }
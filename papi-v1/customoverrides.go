@@ -25,32 +25,13 @@ func NewCustomOverrides() *CustomOverrides {
 	return &CustomOverrides{}
 }
 
-// PostUnmarshalJSON is called after UnmarshalJSON to setup the
-// structs internal state. The CustomOverrides.Complete channel is utilized
-// to communicate full completion.
-func (overrides *CustomOverrides) PostUnmarshalJSON() error {
-	overrides.Init()
-
-	for key, override := range overrides.CustomOverrides.Items {
-		overrides.CustomOverrides.Items[key].parent = overrides
-
-		if err := override.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
-	overrides.Complete <- true
-
-	return nil
-}
-
 // GetCustomOverrides populates a *CustomOverrides with it's related Custom Overrides
 //
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getcustomoverrides
 // Endpoint: GET /papi/v1/custom-overrides
 func (overrides *CustomOverrides) GetCustomOverrides() error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(overrides)
+	req, err := session.NewRequest(
 		"GET",
 		"/papi/v1/custom-overrides",
 		nil,
@@ -61,7 +42,7 @@ func (overrides *CustomOverrides) GetCustomOverrides() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -72,7 +53,7 @@ func (overrides *CustomOverrides) GetCustomOverrides() error {
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, overrides); err != nil {
+	if err = bodyJSON(res, overrides); err != nil {
 		return err
 	}
 
@@ -113,8 +94,8 @@ type CustomOverride struct {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getcustomoverride
 // Endpoint: GET /papi/v1/custom-overrides/{overrideId}
 func (override *CustomOverride) GetCustomOverride() error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(override)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/custom-overrides/%s",
@@ -128,7 +109,7 @@ func (override *CustomOverride) GetCustomOverride() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -140,7 +121,7 @@ func (override *CustomOverride) GetCustomOverride() error {
 	}
 
 	newCustomOverrides := NewCustomOverrides()
-	if err = client.BodyJSON(res, newCustomOverrides); err != nil {
+	if err = bodyJSON(res, newCustomOverrides); err != nil {
 		return err
 	}
 	if len(newCustomOverrides.CustomOverrides.Items) == 0 {
@@ -162,5 +143,15 @@ func (override *CustomOverride) GetCustomOverride() error {
 
 // NewCustomOverride creates a new *CustomOverride
 func NewCustomOverride(overrides *CustomOverrides) *CustomOverride {
-	return &CustomOverride{parent: overrides}
+	override := &CustomOverride{parent: overrides}
+	inherit(override, overrides)
+	return override
+}
+
+// SetParent sets the CustomOverrides the CustomOverride belongs to
+func (override *CustomOverride) SetParent(parent interface{}) {
+	override.Resource.SetParent(parent)
+	if overrides, ok := parent.(*CustomOverrides); ok {
+		override.parent = overrides
+	}
 }
@@ -1,7 +1,7 @@
 package papi
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -10,7 +10,6 @@ import (
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
-	"github.com/patrickmn/go-cache"
 )
 
 // EdgeHostnames is a collection for PAPI Edge Hostname resources
@@ -31,25 +30,6 @@ func NewEdgeHostnames() *EdgeHostnames {
 	return edgeHostnames
 }
 
-// PostUnmarshalJSON is called after JSON unmarshaling into EdgeHostnames
-//
-// See: jsonhooks-v1/jsonhooks.Unmarshal()
-func (edgeHostnames *EdgeHostnames) PostUnmarshalJSON() error {
-	edgeHostnames.Init()
-
-	for key, edgeHostname := range edgeHostnames.EdgeHostnames.Items {
-		edgeHostnames.EdgeHostnames.Items[key].parent = edgeHostnames
-
-		if err := edgeHostname.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
-	edgeHostnames.Complete <- true
-
-	return nil
-}
-
 // NewEdgeHostname creates a new EdgeHostname within a given EdgeHostnames
 func (edgeHostnames *EdgeHostnames) NewEdgeHostname() *EdgeHostname {
 	edgeHostname := NewEdgeHostname(edgeHostnames)
@@ -62,62 +42,57 @@ func (edgeHostnames *EdgeHostnames) NewEdgeHostname() *EdgeHostname {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listedgehostnames
 // Endpoint: GET /papi/v1/edgehostnames/{?contractId,groupId,options}
 func (edgeHostnames *EdgeHostnames) GetEdgeHostnames(contract *Contract, group *Group, options string, correlationid string) error {
+	return edgeHostnames.getEdgeHostnames(sessionOf(edgeHostnames, contract, group), contract, group, options, correlationid)
+}
 
+// getEdgeHostnames populates EdgeHostnames like GetEdgeHostnames, using session
+func (edgeHostnames *EdgeHostnames) getEdgeHostnames(session *client.Session, contract *Contract, group *Group, options string, correlationid string) error {
 	if contract == nil && group == nil {
 		return errors.New("function requires at least \"group\" argument")
 	}
 
-	cacheedgehostnames, found := Profilecache.Get("edgehostnames")
-	if found {
-		json.Unmarshal(cacheedgehostnames.([]byte), edgeHostnames)
-		return nil
-	} else {
-
-		if contract == nil && group != nil {
-			contract = NewContract(NewContracts())
-			contract.ContractID = group.ContractIDs[0]
-		}
-
-		if options != "" {
-			options = fmt.Sprintf("&options=%s", options)
-		}
+	if contract == nil && group != nil {
+		contract = NewContract(NewContracts())
+		contract.ContractID = group.ContractIDs[0]
+	}
 
-		req, err := client.NewRequest(
-			Config,
-			"GET",
-			fmt.Sprintf(
-				"/papi/v1/edgehostnames?groupId=%s&contractId=%s%s",
-				group.GroupID,
-				contract.ContractID,
-				options,
-			),
-			nil,
-		)
-		if err != nil {
-			return err
-		}
+	if options != "" {
+		options = fmt.Sprintf("&options=%s", options)
+	}
 
-		edge.PrintHttpRequestCorrelation(req, true, correlationid)
+	req, err := session.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"/papi/v1/edgehostnames?groupId=%s&contractId=%s%s",
+			group.GroupID,
+			contract.ContractID,
+			options,
+		),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
 
-		res, err := client.Do(Config, req)
-		if err != nil {
-			return err
-		}
+	req = client.CacheFor(req, CacheTTL)
+	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-		edge.PrintHttpResponseCorrelation(res, true, correlationid)
+	res, err := session.Do(req)
+	if err != nil {
+		return err
+	}
 
-		if client.IsError(res) {
-			return client.NewAPIError(res)
-		}
+	edge.PrintHttpResponseCorrelation(res, true, correlationid)
 
-		if err = client.BodyJSON(res, edgeHostnames); err != nil {
-			return err
-		}
+	if client.IsError(res) {
+		return client.NewAPIError(res)
+	}
 
-		byt, _ := json.Marshal(edgeHostnames)
-		Profilecache.Set("edgehostnames", byt, cache.DefaultExpiration)
-		return nil
+	if err = bodyJSON(res, edgeHostnames); err != nil {
+		return err
 	}
+
+	return nil
 }
 
 func (edgeHostnames *EdgeHostnames) FindEdgeHostname(edgeHostname *EdgeHostname) (*EdgeHostname, error) {
@@ -182,9 +157,18 @@ type EdgeHostname struct {
 func NewEdgeHostname(edgeHostnames *EdgeHostnames) *EdgeHostname {
 	edgeHostname := &EdgeHostname{parent: edgeHostnames}
 	edgeHostname.Init()
+	inherit(edgeHostname, edgeHostnames)
 	return edgeHostname
 }
 
+// SetParent sets the EdgeHostnames the EdgeHostname belongs to
+func (edgeHostname *EdgeHostname) SetParent(parent interface{}) {
+	edgeHostname.Resource.SetParent(parent)
+	if edgeHostnames, ok := parent.(*EdgeHostnames); ok {
+		edgeHostname.parent = edgeHostnames
+	}
+}
+
 func (edgeHostname *EdgeHostname) Init() {
 	edgeHostname.Complete = make(chan bool, 1)
 	edgeHostname.StatusChange = make(chan bool, 1)
@@ -195,12 +179,16 @@ func (edgeHostname *EdgeHostname) Init() {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getanedgehostname
 // Endpoint: GET /papi/v1/edgehostnames/{edgeHostnameId}{?contractId,groupId,options}
 func (edgeHostname *EdgeHostname) GetEdgeHostname(options string, correlationid string) error {
+	return edgeHostname.getEdgeHostname(sessionOf(edgeHostname), options, correlationid)
+}
+
+// getEdgeHostname populates EdgeHostname like GetEdgeHostname, using session
+func (edgeHostname *EdgeHostname) getEdgeHostname(session *client.Session, options string, correlationid string) error {
 	if options != "" {
 		options = "&options=" + options
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/edgehostnames/%s?contractId=%s&groupId=%s%s",
@@ -217,7 +205,7 @@ func (edgeHostname *EdgeHostname) GetEdgeHostname(options string, correlationid
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -232,7 +220,7 @@ func (edgeHostname *EdgeHostname) GetEdgeHostname(options string, correlationid
 			group := NewGroup(NewGroups())
 			group.GroupID = edgeHostname.parent.GroupID
 
-			edgeHostname.parent.GetEdgeHostnames(contract, group, "", correlationid)
+			edgeHostname.parent.getEdgeHostnames(session, contract, group, "", correlationid)
 			newEdgeHostname, err := edgeHostname.parent.FindEdgeHostname(edgeHostname)
 			if err != nil || newEdgeHostname == nil {
 				return client.NewAPIError(res)
@@ -257,7 +245,7 @@ func (edgeHostname *EdgeHostname) GetEdgeHostname(options string, correlationid
 	}
 
 	newEdgeHostnames := NewEdgeHostnames()
-	if err := client.BodyJSON(res, newEdgeHostnames); err != nil {
+	if err := bodyJSON(res, newEdgeHostnames); err != nil {
 		return err
 	}
 
@@ -281,11 +269,11 @@ func (edgeHostname *EdgeHostname) GetEdgeHostname(options string, correlationid
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#createanewedgehostname
 // Endpoint: POST /papi/v1/edgehostnames/{?contractId,groupId,options}
 func (edgeHostname *EdgeHostname) Save(options string, correlationid string) error {
+	session := sessionOf(edgeHostname)
 	if options != "" {
 		options = "&options=" + options
 	}
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf(
 			"/papi/v1/edgehostnames/?contractId=%s&groupId=%s%s",
@@ -301,7 +289,7 @@ func (edgeHostname *EdgeHostname) Save(options string, correlationid string) err
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -313,7 +301,7 @@ func (edgeHostname *EdgeHostname) Save(options string, correlationid string) err
 	}
 
 	var location client.JSONBody
-	if err = client.BodyJSON(res, &location); err != nil {
+	if err = bodyJSON(res, &location); err != nil {
 		return err
 	}
 
@@ -352,17 +340,28 @@ func (edgeHostname *EdgeHostname) Save(options string, correlationid string) err
 //		// EdgeHostname activated successfully
 //	}
 func (edgeHostname *EdgeHostname) PollStatus(options string, correlationid string) bool {
+	return edgeHostname.PollStatusWithContext(sessionOf(edgeHostname).Context(), options, correlationid)
+}
+
+// PollStatusWithContext polls like PollStatus, with ctx. Polling stops when
+// ctx is done, sending false to the EdgeHostname.StatusChange channel.
+func (edgeHostname *EdgeHostname) PollStatusWithContext(ctx context.Context, options string, correlationid string) bool {
+	session := sessionOf(edgeHostname).WithContext(ctx)
+
 	currentStatus := edgeHostname.Status
 	var retry time.Duration = 0
 	for currentStatus != StatusActive {
-		time.Sleep(retry)
+		if err := client.Sleep(ctx, retry); err != nil {
+			edgeHostname.StatusChange <- false
+			return false
+		}
 		if retry == 0 {
 			retry = time.Minute * 3
 		}
 
 		retry -= time.Minute
 
-		err := edgeHostname.GetEdgeHostname(options, correlationid)
+		err := edgeHostname.getEdgeHostname(session, options, correlationid)
 		if err != nil {
 			edgeHostname.StatusChange <- false
 			return false
@@ -0,0 +1,47 @@
+package papi
+
+import (
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestClient_GetEdgeHostnamesCachedPerContract(t *testing.T) {
+	defer gock.Off()
+
+	for _, contractID := range []string{"ctr_1", "ctr_2"} {
+		gock.New("https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+			Get("/papi/v1/edgehostnames").
+			MatchParam("contractId", contractID).
+			Reply(200).
+			JSON(map[string]interface{}{
+				"contractId": contractID,
+				"groupId":    "grp_1",
+				"edgeHostnames": map[string]interface{}{
+					"items": []map[string]string{{"edgeHostnameId": "ehn_" + contractID}},
+				},
+			})
+	}
+
+	session := client.NewSession(config)
+	session.Cache = client.NewResponseCache()
+	c := New(session)
+	group := NewGroup(NewGroups())
+	group.GroupID = "grp_1"
+
+	// The mocks reply once, so the last call is served from the cache
+	for _, contractID := range []string{"ctr_1", "ctr_2", "ctr_1"} {
+		contract := NewContract(NewContracts())
+		contract.ContractID = contractID
+		edgeHostnames, err := c.GetEdgeHostnames(contract, group, "")
+		require.NoError(t, err)
+		assert.Equal(t, contractID, edgeHostnames.ContractID)
+		if assert.Len(t, edgeHostnames.EdgeHostnames.Items, 1) {
+			assert.Equal(t, "ehn_"+contractID, edgeHostnames.EdgeHostnames.Items[0].EdgeHostnameID)
+		}
+	}
+	assert.True(t, gock.IsDone())
+}
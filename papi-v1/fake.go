@@ -0,0 +1,186 @@
+package papi
+
+import "sync"
+
+// Fake is a configurable implementation of PropertyService and ActivationService,
+// for unit tests of code using them without any HTTP. Each method records
+// its call, then calls the function of its name with a Func suffix, e.g.
+// GetPropertyFunc, if set, or else returns zero values.
+//
+//	fake := &papi.Fake{
+//		GetPropertyFunc: func(propertyID string) (*papi.Property, error) {
+//			property := papi.NewProperty(papi.NewProperties())
+//			property.PropertyID = propertyID
+//			return property, nil
+//		},
+//	}
+type Fake struct {
+	GetGroupsFunc        func() (*Groups, error)
+	GetContractsFunc     func() (*Contracts, error)
+	GetPropertiesFunc    func(contract *Contract, group *Group) (*Properties, error)
+	GetPropertyFunc      func(propertyID string) (*Property, error)
+	CreatePropertyFunc   func(property *Property) error
+	DeletePropertyFunc   func(property *Property) error
+	GetVersionsFunc      func(property *Property) (*Versions, error)
+	GetLatestVersionFunc func(property *Property, activatedOn NetworkValue) (*Version, error)
+	CreateVersionFunc    func(property *Property, createFromVersion *Version) (*Version, error)
+	GetRulesFunc         func(property *Property) (*Rules, error)
+	UpdateRulesFunc      func(rules *Rules) error
+	GetActivationsFunc   func(property *Property) (*Activations, error)
+	ActivateFunc         func(property *Property, activation *Activation, acknowledgeWarnings bool) error
+	GetActivationFunc    func(property *Property, activationID string) (*Activation, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+// FakeCall is a call of a method of a Fake, with its arguments
+type FakeCall struct {
+	Method string
+	Args   []interface{}
+}
+
+var (
+	_ PropertyService   = (*Fake)(nil)
+	_ ActivationService = (*Fake)(nil)
+)
+
+// Calls returns the calls made so far, in order
+func (f *Fake) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// record records a call of method with args
+func (f *Fake) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args})
+}
+
+// GetGroups calls GetGroupsFunc
+func (f *Fake) GetGroups() (*Groups, error) {
+	f.record("GetGroups")
+	if f.GetGroupsFunc == nil {
+		return nil, nil
+	}
+	return f.GetGroupsFunc()
+}
+
+// GetContracts calls GetContractsFunc
+func (f *Fake) GetContracts() (*Contracts, error) {
+	f.record("GetContracts")
+	if f.GetContractsFunc == nil {
+		return nil, nil
+	}
+	return f.GetContractsFunc()
+}
+
+// GetProperties calls GetPropertiesFunc
+func (f *Fake) GetProperties(contract *Contract, group *Group) (*Properties, error) {
+	f.record("GetProperties", contract, group)
+	if f.GetPropertiesFunc == nil {
+		return nil, nil
+	}
+	return f.GetPropertiesFunc(contract, group)
+}
+
+// GetProperty calls GetPropertyFunc
+func (f *Fake) GetProperty(propertyID string) (*Property, error) {
+	f.record("GetProperty", propertyID)
+	if f.GetPropertyFunc == nil {
+		return nil, nil
+	}
+	return f.GetPropertyFunc(propertyID)
+}
+
+// CreateProperty calls CreatePropertyFunc
+func (f *Fake) CreateProperty(property *Property) error {
+	f.record("CreateProperty", property)
+	if f.CreatePropertyFunc == nil {
+		return nil
+	}
+	return f.CreatePropertyFunc(property)
+}
+
+// DeleteProperty calls DeletePropertyFunc
+func (f *Fake) DeleteProperty(property *Property) error {
+	f.record("DeleteProperty", property)
+	if f.DeletePropertyFunc == nil {
+		return nil
+	}
+	return f.DeletePropertyFunc(property)
+}
+
+// GetVersions calls GetVersionsFunc
+func (f *Fake) GetVersions(property *Property) (*Versions, error) {
+	f.record("GetVersions", property)
+	if f.GetVersionsFunc == nil {
+		return nil, nil
+	}
+	return f.GetVersionsFunc(property)
+}
+
+// GetLatestVersion calls GetLatestVersionFunc
+func (f *Fake) GetLatestVersion(property *Property, activatedOn NetworkValue) (*Version, error) {
+	f.record("GetLatestVersion", property, activatedOn)
+	if f.GetLatestVersionFunc == nil {
+		return nil, nil
+	}
+	return f.GetLatestVersionFunc(property, activatedOn)
+}
+
+// CreateVersion calls CreateVersionFunc
+func (f *Fake) CreateVersion(property *Property, createFromVersion *Version) (*Version, error) {
+	f.record("CreateVersion", property, createFromVersion)
+	if f.CreateVersionFunc == nil {
+		return nil, nil
+	}
+	return f.CreateVersionFunc(property, createFromVersion)
+}
+
+// GetRules calls GetRulesFunc
+func (f *Fake) GetRules(property *Property) (*Rules, error) {
+	f.record("GetRules", property)
+	if f.GetRulesFunc == nil {
+		return nil, nil
+	}
+	return f.GetRulesFunc(property)
+}
+
+// UpdateRules calls UpdateRulesFunc
+func (f *Fake) UpdateRules(rules *Rules) error {
+	f.record("UpdateRules", rules)
+	if f.UpdateRulesFunc == nil {
+		return nil
+	}
+	return f.UpdateRulesFunc(rules)
+}
+
+// GetActivations calls GetActivationsFunc
+func (f *Fake) GetActivations(property *Property) (*Activations, error) {
+	f.record("GetActivations", property)
+	if f.GetActivationsFunc == nil {
+		return nil, nil
+	}
+	return f.GetActivationsFunc(property)
+}
+
+// Activate calls ActivateFunc
+func (f *Fake) Activate(property *Property, activation *Activation, acknowledgeWarnings bool) error {
+	f.record("Activate", property, activation, acknowledgeWarnings)
+	if f.ActivateFunc == nil {
+		return nil
+	}
+	return f.ActivateFunc(property, activation, acknowledgeWarnings)
+}
+
+// GetActivation calls GetActivationFunc
+func (f *Fake) GetActivation(property *Property, activationID string) (*Activation, error) {
+	f.record("GetActivation", property, activationID)
+	if f.GetActivationFunc == nil {
+		return nil, nil
+	}
+	return f.GetActivationFunc(property, activationID)
+}
@@ -0,0 +1,42 @@
+package papi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake_Funcs(t *testing.T) {
+	boom := errors.New("boom")
+	fake := &Fake{
+		GetPropertyFunc: func(propertyID string) (*Property, error) {
+			property := NewProperty(NewProperties())
+			property.PropertyID = propertyID
+			return property, nil
+		},
+		ActivateFunc: func(property *Property, activation *Activation, acknowledgeWarnings bool) error {
+			return boom
+		},
+	}
+	var properties PropertyService = fake
+	var activations ActivationService = fake
+
+	property, err := properties.GetProperty("prp_1")
+	require.NoError(t, err)
+	assert.Equal(t, "prp_1", property.PropertyID)
+
+	activation := NewActivation(NewActivations())
+	assert.Equal(t, boom, activations.Activate(property, activation, true))
+
+	groups, err := properties.GetGroups()
+	assert.NoError(t, err)
+	assert.Nil(t, groups)
+
+	assert.Equal(t, []FakeCall{
+		{Method: "GetProperty", Args: []interface{}{"prp_1"}},
+		{Method: "Activate", Args: []interface{}{property, activation, true}},
+		{Method: "GetGroups", Args: []interface{}(nil)},
+	}, fake.Calls())
+}
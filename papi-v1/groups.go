@@ -1,12 +1,10 @@
 package papi
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
-	"github.com/patrickmn/go-cache"
 )
 
 // Groups represents a collection of PAPI groups
@@ -25,63 +23,40 @@ func NewGroups() *Groups {
 	return groups
 }
 
-// PostUnmarshalJSON is called after JSON unmarshaling into EdgeHostnames
-//
-// See: jsonhooks-v1/jsonhooks.Unmarshal()
-func (groups *Groups) PostUnmarshalJSON() error {
-	groups.Init()
-	for key, group := range groups.Groups.Items {
-		groups.Groups.Items[key].parent = groups
-		if err := group.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
-	groups.Complete <- true
-
-	return nil
-}
-
 // GetGroups populates Groups with group data
 //
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listgroups
 // Endpoint: GET /papi/v1/groups/
 func (groups *Groups) GetGroups(correlationid string) error {
-	cachegroups, found := Profilecache.Get("groups")
-	if found {
-		json.Unmarshal(cachegroups.([]byte), groups)
-		return nil
-	} else {
-		req, err := client.NewRequest(
-			Config,
-			"GET",
-			"/papi/v1/groups",
-			nil,
-		)
-		if err != nil {
-			return err
-		}
+	session := sessionOf(groups)
+	req, err := session.NewRequest(
+		"GET",
+		"/papi/v1/groups",
+		nil,
+	)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpRequestCorrelation(req, true, correlationid)
+	req = client.CacheFor(req, CacheTTL)
+	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-		res, err := client.Do(Config, req)
-		if err != nil {
-			return err
-		}
+	res, err := session.Do(req)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpResponseCorrelation(res, true, correlationid)
+	edge.PrintHttpResponseCorrelation(res, true, correlationid)
 
-		if client.IsError(res) {
-			return client.NewAPIError(res)
-		}
+	if client.IsError(res) {
+		return client.NewAPIError(res)
+	}
 
-		if err = client.BodyJSON(res, groups); err != nil {
-			return err
-		}
-		byt, _ := json.Marshal(groups)
-		Profilecache.Set("groups", byt, cache.DefaultExpiration)
-		return nil
+	if err = bodyJSON(res, groups); err != nil {
+		return err
 	}
+
+	return nil
 }
 
 // AddGroup adds a group to a Groups collection
@@ -191,12 +166,21 @@ func NewGroup(parent *Groups) *Group {
 		parent: parent,
 	}
 	group.Init()
+	inherit(group, parent)
 	return group
 }
 
+// SetParent sets the Groups the Group belongs to
+func (group *Group) SetParent(parent interface{}) {
+	group.Resource.SetParent(parent)
+	if groups, ok := parent.(*Groups); ok {
+		group.parent = groups
+	}
+}
+
 // GetGroup populates a Group
 func (group *Group) GetGroup() {
-	groups, err := GetGroups()
+	groups, err := clientOf(group).GetGroups()
 	if err != nil {
 		return
 	}
@@ -217,22 +201,23 @@ func (group *Group) GetGroup() {
 
 // GetProperties retrieves all properties associated with a given group and contract
 func (group *Group) GetProperties(contract *Contract) (*Properties, error) {
-	return GetProperties(contract, group)
+	return clientOf(group, contract).GetProperties(contract, group)
 }
 
 // GetCpCodes retrieves all CP codes associated with a given group and contract
 func (group *Group) GetCpCodes(contract *Contract) (*CpCodes, error) {
-	return GetCpCodes(contract, group)
+	return clientOf(group, contract).GetCpCodes(contract, group)
 }
 
 // GetEdgeHostnames retrieves all Edge hostnames associated with a given group/contract
 func (group *Group) GetEdgeHostnames(contract *Contract, options string, correlationid string) (*EdgeHostnames, error) {
-	return GetEdgeHostnames(contract, group, options)
+	return clientOf(group, contract).GetEdgeHostnames(contract, group, options)
 }
 
 // NewProperty creates a property associated with a given group/contract
 func (group *Group) NewProperty(contract *Contract) (*Property, error) {
 	property := NewProperty(NewProperties())
+	inherit(property, group)
 	property.Contract = contract
 	property.Group = group
 	return property, nil
@@ -29,24 +29,6 @@ func NewHostnames() *Hostnames {
 	return hostnames
 }
 
-// PostUnmarshalJSON is called after JSON unmarshaling into EdgeHostnames
-//
-// See: jsonhooks-v1/jsonhooks.Unmarshal()
-func (hostnames *Hostnames) PostUnmarshalJSON() error {
-	hostnames.Init()
-
-	for key, hostname := range hostnames.Hostnames.Items {
-		hostnames.Hostnames.Items[key].parent = hostnames
-		if err := hostname.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
-	hostnames.Complete <- true
-
-	return nil
-}
-
 // GetHostnames retrieves hostnames assigned to a given property
 //
 // If no version is given, the latest version is used
@@ -55,8 +37,10 @@ func (hostnames *Hostnames) PostUnmarshalJSON() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listapropertyshostnames
 // Endpoint: GET /papi/v1/properties/{propertyId}/versions/{propertyVersion}/hostnames/{?contractId,groupId}
 func (hostnames *Hostnames) GetHostnames(version *Version, correlationid string) error {
+	session := sessionOf(hostnames, version)
 	if version == nil {
 		property := NewProperty(NewProperties())
+		property.SetSession(session)
 		property.PropertyID = hostnames.PropertyID
 		err := property.GetProperty(correlationid)
 		if err != nil {
@@ -69,8 +53,7 @@ func (hostnames *Hostnames) GetHostnames(version *Version, correlationid string)
 		}
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/hostnames/?contractId=%s&groupId=%s",
@@ -87,7 +70,7 @@ func (hostnames *Hostnames) GetHostnames(version *Version, correlationid string)
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -98,7 +81,7 @@ func (hostnames *Hostnames) GetHostnames(version *Version, correlationid string)
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, hostnames); err != nil {
+	if err = bodyJSON(res, hostnames); err != nil {
 		return err
 	}
 
@@ -114,8 +97,8 @@ func (hostnames *Hostnames) NewHostname() *Hostname {
 
 // Save updates a properties hostnames
 func (hostnames *Hostnames) Save() error {
-	req, err := client.NewJSONRequest(
-		Config,
+	session := sessionOf(hostnames)
+	req, err := session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/hostnames?contractId=%s&groupId=%s",
@@ -132,7 +115,7 @@ func (hostnames *Hostnames) Save() error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -143,7 +126,7 @@ func (hostnames *Hostnames) Save() error {
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, hostnames); err != nil {
+	if err = bodyJSON(res, hostnames); err != nil {
 		return err
 	}
 
@@ -166,9 +149,18 @@ func NewHostname(parent *Hostnames) *Hostname {
 	hostname := &Hostname{parent: parent, CnameType: CnameTypeEdgeHostname}
 	hostname.Init()
 
+	inherit(hostname, parent)
 	return hostname
 }
 
+// SetParent sets the Hostnames the Hostname belongs to
+func (hostname *Hostname) SetParent(parent interface{}) {
+	hostname.Resource.SetParent(parent)
+	if hostnames, ok := parent.(*Hostnames); ok {
+		hostname.parent = hostnames
+	}
+}
+
 // CnameTypeValue is used to create an "enum" of possible Hostname.CnameType values
 type CnameTypeValue string
 
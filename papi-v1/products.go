@@ -1,12 +1,10 @@
 package papi
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	edge "github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
-	"github.com/patrickmn/go-cache"
 )
 
 // Products represents a collection of products
@@ -33,13 +31,6 @@ func NewProducts() *Products {
 func (products *Products) PostUnmarshalJSON() error {
 	products.Init()
 
-	for key, product := range products.Products.Items {
-		products.Products.Items[key].parent = products
-		if err := product.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
 	return nil
 }
 
@@ -48,46 +39,38 @@ func (products *Products) PostUnmarshalJSON() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listproducts
 // Endpoint: GET /papi/v1/products/{?contractId}
 func (products *Products) GetProducts(contract *Contract, correlationid string) error {
-	cacheproducts, found := Profilecache.Get("products")
-	if found {
-		json.Unmarshal(cacheproducts.([]byte), products)
-		return nil
-	} else {
-		req, err := client.NewRequest(
-			Config,
-			"GET",
-			fmt.Sprintf(
-				"/papi/v1/products?contractId=%s",
-				contract.ContractID,
-			),
-			nil,
-		)
-		if err != nil {
-			return err
-		}
+	session := sessionOf(products, contract)
+	req, err := session.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"/papi/v1/products?contractId=%s",
+			contract.ContractID,
+		),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpRequestCorrelation(req, true, correlationid)
+	req = client.CacheFor(req, CacheTTL)
+	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-		res, err := client.Do(Config, req)
-		if err != nil {
-			return err
-		}
+	res, err := session.Do(req)
+	if err != nil {
+		return err
+	}
 
-		edge.PrintHttpResponseCorrelation(res, true, correlationid)
+	edge.PrintHttpResponseCorrelation(res, true, correlationid)
 
-		if client.IsError(res) {
-			return client.NewAPIError(res)
-		}
-
-		if err = client.BodyJSON(res, products); err != nil {
-			return err
-		}
+	if client.IsError(res) {
+		return client.NewAPIError(res)
+	}
 
-		byt, _ := json.Marshal(products)
-		Profilecache.Set("products", byt, cache.DefaultExpiration)
-		return nil
+	if err = bodyJSON(res, products); err != nil {
+		return err
 	}
 
+	return nil
 }
 
 // FindProduct finds a specific product by ID
@@ -121,5 +104,14 @@ func NewProduct(parent *Products) *Product {
 	product := &Product{parent: parent}
 	product.Init()
 
+	inherit(product, parent)
 	return product
 }
+
+// SetParent sets the Products the Product belongs to
+func (product *Product) SetParent(parent interface{}) {
+	product.Resource.SetParent(parent)
+	if products, ok := parent.(*Products); ok {
+		product.parent = products
+	}
+}
@@ -23,36 +23,18 @@ func NewProperties() *Properties {
 	return properties
 }
 
-// PostUnmarshalJSON is called after JSON unmarshaling into EdgeHostnames
-//
-// See: jsonhooks-v1/jsonhooks.Unmarshal()
-func (properties *Properties) PostUnmarshalJSON() error {
-	properties.Init()
-
-	for key, property := range properties.Properties.Items {
-		properties.Properties.Items[key].parent = properties
-		if err := property.PostUnmarshalJSON(); err != nil {
-			return err
-		}
-	}
-
-	properties.Complete <- true
-
-	return nil
-}
-
 // GetProperties populates Properties with property data
 //
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listproperties
 // Endpoint: GET /papi/v1/properties/{?contractId,groupId}
 func (properties *Properties) GetProperties(contract *Contract, group *Group, correlationid string) error {
+	session := sessionOf(properties, contract, group)
 	if contract == nil {
 		contract = NewContract(NewContracts())
 		contract.ContractID = group.ContractIDs[0]
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties?groupId=%s&contractId=%s",
@@ -68,7 +50,7 @@ func (properties *Properties) GetProperties(contract *Contract, group *Group, co
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 
 	if err != nil {
 		return nil
@@ -80,7 +62,7 @@ func (properties *Properties) GetProperties(contract *Contract, group *Group, co
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, properties); err != nil {
+	if err = bodyJSON(res, properties); err != nil {
 		return err
 	}
 
@@ -165,9 +147,18 @@ type Property struct {
 func NewProperty(parent *Properties) *Property {
 	property := &Property{parent: parent, Group: &Group{}, Contract: &Contract{}}
 	property.Init()
+	inherit(property, parent)
 	return property
 }
 
+// SetParent sets the Properties the Property belongs to
+func (property *Property) SetParent(parent interface{}) {
+	property.Resource.SetParent(parent)
+	if properties, ok := parent.(*Properties); ok {
+		property.parent = properties
+	}
+}
+
 // PreMarshalJSON is called before JSON marshaling
 //
 // See: jsonhooks-v1/json.Marshal()
@@ -182,8 +173,8 @@ func (property *Property) PreMarshalJSON() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getaproperty
 // Endpoint: GET /papi/v1/properties/{propertyId}{?contractId,groupId}
 func (property *Property) GetProperty(correlationid string) error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(property)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s",
@@ -197,7 +188,7 @@ func (property *Property) GetProperty(correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -209,7 +200,7 @@ func (property *Property) GetProperty(correlationid string) error {
 	}
 
 	newProperties := NewProperties()
-	if err := client.BodyJSON(res, newProperties); err != nil {
+	if err := bodyJSON(res, newProperties); err != nil {
 		return err
 	}
 
@@ -347,9 +338,11 @@ func (property *Property) PostUnmarshalJSON() error {
 	property.Init()
 
 	property.Contract = NewContract(NewContracts())
+	inherit(property.Contract, property)
 	property.Contract.ContractID = property.ContractID
 
 	property.Group = NewGroup(NewGroups())
+	inherit(property.Group, property)
 	property.Group.GroupID = property.GroupID
 
 	go property.Group.GetGroup()
@@ -369,8 +362,8 @@ func (property *Property) PostUnmarshalJSON() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#createorcloneaproperty
 // Endpoint: POST /papi/v1/properties/{?contractId,groupId}
 func (property *Property) Save(correlationid string) error {
-	req, err := client.NewJSONRequest(
-		Config,
+	session := sessionOf(property)
+	req, err := session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf(
 			"/papi/v1/properties?contractId=%s&groupId=%s",
@@ -385,7 +378,7 @@ func (property *Property) Save(correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -397,12 +390,11 @@ func (property *Property) Save(correlationid string) error {
 	}
 
 	var location client.JSONBody
-	if err = client.BodyJSON(res, &location); err != nil {
+	if err = bodyJSON(res, &location); err != nil {
 		return err
 	}
 
-	req, err = client.NewRequest(
-		Config,
+	req, err = session.NewRequest(
 		"GET",
 		location["propertyLink"].(string),
 		nil,
@@ -413,7 +405,7 @@ func (property *Property) Save(correlationid string) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err = client.Do(Config, req)
+	res, err = session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -425,7 +417,7 @@ func (property *Property) Save(correlationid string) error {
 	}
 
 	properties := NewProperties()
-	if err = client.BodyJSON(res, properties); err != nil {
+	if err = bodyJSON(res, properties); err != nil {
 		return err
 	}
 
@@ -463,9 +455,9 @@ func (property *Property) Activate(activation *Activation, acknowledgeWarnings b
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#removeaproperty
 // Endpoint: DELETE /papi/v1/properties/{propertyId}{?contractId,groupId}
 func (property *Property) Delete(correlationid string) error {
+	session := sessionOf(property)
 	// /papi/v1/properties/{propertyId}{?contractId,groupId}
-	req, err := client.NewRequest(
-		Config,
+	req, err := session.NewRequest(
 		"DELETE",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s",
@@ -479,7 +471,7 @@ func (property *Property) Delete(correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
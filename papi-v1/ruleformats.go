@@ -31,8 +31,8 @@ func NewRuleFormats() *RuleFormats {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listruleformats
 // Endpoint: GET /papi/v1/rule-formats
 func (ruleFormats *RuleFormats) GetRuleFormats(correlationid string) error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(ruleFormats)
+	req, err := session.NewRequest(
 		"GET",
 		"/papi/v1/rule-formats",
 		nil,
@@ -43,7 +43,7 @@ func (ruleFormats *RuleFormats) GetRuleFormats(correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -54,7 +54,7 @@ func (ruleFormats *RuleFormats) GetRuleFormats(correlationid string) error {
 		return client.NewAPIError(res)
 	}
 
-	if err := client.BodyJSON(res, ruleFormats); err != nil {
+	if err := bodyJSON(res, ruleFormats); err != nil {
 		return err
 	}
 
@@ -79,8 +79,8 @@ func (ruleFormats *RuleFormats) GetLatest(correlationid string) (string, error)
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getaruleformatsschema
 // Endpoint: /papi/v1/schemas/products/{productId}/{ruleFormat}
 func (ruleFormats *RuleFormats) GetSchema(product string, ruleFormat string, correlationid string) (*gojsonschema.Schema, error) {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(ruleFormats)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/schemas/products/%s/%s",
@@ -95,7 +95,7 @@ func (ruleFormats *RuleFormats) GetSchema(product string, ruleFormat string, cor
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +46,8 @@ func (rules *Rules) PreMarshalJSON() error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getaruletree
 // Endpoint: GET /papi/v1/properties/{propertyId}/versions/{propertyVersion}/rules/{?contractId,groupId}
 func (rules *Rules) GetRules(property *Property, correlationid string) error {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(rules, property)
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/rules",
@@ -62,7 +62,7 @@ func (rules *Rules) GetRules(property *Property, correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -73,7 +73,7 @@ func (rules *Rules) GetRules(property *Property, correlationid string) error {
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, rules); err != nil {
+	if err = bodyJSON(res, rules); err != nil {
 		return err
 	}
 
@@ -86,8 +86,8 @@ func (rules *Rules) GetRules(property *Property, correlationid string) error {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getaruletreesdigest
 // Endpoint: HEAD /papi/v1/properties/{propertyId}/versions/{propertyVersion}/rules/{?contractId,groupId}
 func (rules *Rules) GetRulesDigest(property *Property, correlationid string) (string, error) {
-	req, err := client.NewRequest(
-		Config,
+	session := sessionOf(rules, property)
+	req, err := session.NewRequest(
 		"HEAD",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/rules",
@@ -102,7 +102,7 @@ func (rules *Rules) GetRulesDigest(property *Property, correlationid string) (st
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return "", err
 	}
@@ -121,10 +121,10 @@ func (rules *Rules) GetRulesDigest(property *Property, correlationid string) (st
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#putpropertyversionrules
 // Endpoint: PUT /papi/v1/properties/{propertyId}/versions/{propertyVersion}/rules{?contractId,groupId}
 func (rules *Rules) Save(correlationid string) error {
+	session := sessionOf(rules)
 	rules.Errors = []*RuleErrors{}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/rules",
@@ -139,7 +139,7 @@ func (rules *Rules) Save(correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -150,7 +150,7 @@ func (rules *Rules) Save(correlationid string) error {
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, rules); err != nil {
+	if err = bodyJSON(res, rules); err != nil {
 		return err
 	}
 
@@ -163,10 +163,10 @@ func (rules *Rules) Save(correlationid string) error {
 
 // Freeze pins a properties rule set to a specific rule set version
 func (rules *Rules) Freeze(format string) error {
+	session := sessionOf(rules)
 	rules.Errors = []*RuleErrors{}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := session.NewJSONRequest(
 		"PUT",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d/rules",
@@ -183,7 +183,7 @@ func (rules *Rules) Freeze(format string) error {
 
 	req.Header.Set("Content-Type", fmt.Sprintf("application/vnd.akamai.papirules.%s+json", format))
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -194,7 +194,7 @@ func (rules *Rules) Freeze(format string) error {
 		return client.NewAPIError(res)
 	}
 
-	if err = client.BodyJSON(res, rules); err != nil {
+	if err = bodyJSON(res, rules); err != nil {
 		return err
 	}
 
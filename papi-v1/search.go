@@ -37,9 +37,8 @@ type SearchResult struct {
 //
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#postfindbyvalue
 // Endpoint: POST /papi/v1/search/find-by-value
-func Search(searchBy SearchKey, propertyName string, correlationid string) (*SearchResult, error) {
-	req, err := client.NewJSONRequest(
-		Config,
+func (c *Client) Search(searchBy SearchKey, propertyName string, correlationid string) (*SearchResult, error) {
+	req, err := c.session.NewJSONRequest(
 		"POST",
 		"/papi/v1/search/find-by-value",
 		map[string]string{(string)(searchBy): propertyName},
@@ -51,7 +50,7 @@ func Search(searchBy SearchKey, propertyName string, correlationid string) (*Sea
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +62,7 @@ func Search(searchBy SearchKey, propertyName string, correlationid string) (*Sea
 	}
 
 	results := &SearchResult{}
-	if err = client.BodyJSON(res, results); err != nil {
+	if err = bodyJSON(res, results); err != nil {
 		return nil, err
 	}
 
@@ -73,3 +72,10 @@ func Search(searchBy SearchKey, propertyName string, correlationid string) (*Sea
 
 	return results, nil
 }
+
+// Search searches for properties
+//
+// See: Client.Search()
+func Search(searchBy SearchKey, propertyName string, correlationid string) (*SearchResult, error) {
+	return defaultClient.Search(searchBy, propertyName, correlationid)
+}
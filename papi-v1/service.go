@@ -3,18 +3,32 @@ package papi
 import (
 	"time"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
 	"github.com/patrickmn/go-cache"
 )
 
 var (
-	Config       edgegrid.Config
+	Config edgegrid.Config
+
+	// CacheTTL is the time the groups, contracts, products, CP codes and edge
+	// hostnames are served without revalidation, by the client.ResponseCache
+	// of the session if it has one
+	CacheTTL = 5 * time.Minute
+
+	// Profilecache is no longer used.
+	//
+	// Deprecated: responses are cached for CacheTTL by the Cache of the
+	// client.Session, or client.Cache, once set.
 	Profilecache = cache.New(5*time.Minute, 10*time.Minute)
+
+	// defaultClient is used by the package-level functions and unbound resources
+	defaultClient = New(client.NewLiveSession(&Config))
 )
 
 // GetGroups retrieves all groups
-func GetGroups() (*Groups, error) {
-	groups := NewGroups()
+func (c *Client) GetGroups() (*Groups, error) {
+	groups := c.NewGroups()
 	if err := groups.GetGroups(""); err != nil {
 		return nil, err
 	}
@@ -23,8 +37,8 @@ func GetGroups() (*Groups, error) {
 }
 
 // GetContracts retrieves all contracts
-func GetContracts() (*Contracts, error) {
-	contracts := NewContracts()
+func (c *Client) GetContracts() (*Contracts, error) {
+	contracts := c.NewContracts()
 	if err := contracts.GetContracts(""); err != nil {
 		return nil, err
 	}
@@ -33,8 +47,8 @@ func GetContracts() (*Contracts, error) {
 }
 
 // GetProducts retrieves all products
-func GetProducts(contract *Contract) (*Products, error) {
-	products := NewProducts()
+func (c *Client) GetProducts(contract *Contract) (*Products, error) {
+	products := c.NewProducts()
 	if err := products.GetProducts(contract, ""); err != nil {
 		return nil, err
 	}
@@ -43,8 +57,8 @@ func GetProducts(contract *Contract) (*Products, error) {
 }
 
 // GetEdgeHostnames retrieves all edge hostnames
-func GetEdgeHostnames(contract *Contract, group *Group, options string) (*EdgeHostnames, error) {
-	edgeHostnames := NewEdgeHostnames()
+func (c *Client) GetEdgeHostnames(contract *Contract, group *Group, options string) (*EdgeHostnames, error) {
+	edgeHostnames := c.NewEdgeHostnames()
 	if err := edgeHostnames.GetEdgeHostnames(contract, group, options, ""); err != nil {
 		return nil, err
 	}
@@ -55,8 +69,8 @@ func GetEdgeHostnames(contract *Contract, group *Group, options string) (*EdgeHo
 // GetCpCodes creates a new CpCodes struct and populates it with all CP Codes associated with a contract/group
 //
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listcpcodes
-func GetCpCodes(contract *Contract, group *Group) (*CpCodes, error) {
-	cpcodes := NewCpCodes(contract, group)
+func (c *Client) GetCpCodes(contract *Contract, group *Group) (*CpCodes, error) {
+	cpcodes := c.NewCpCodes(contract, group)
 	if err := cpcodes.GetCpCodes(""); err != nil {
 		return nil, err
 	}
@@ -65,8 +79,8 @@ func GetCpCodes(contract *Contract, group *Group) (*CpCodes, error) {
 }
 
 // GetProperties retrieves all properties for a given contract/group
-func GetProperties(contract *Contract, group *Group) (*Properties, error) {
-	properties := NewProperties()
+func (c *Client) GetProperties(contract *Contract, group *Group) (*Properties, error) {
+	properties := c.NewProperties()
 	if err := properties.GetProperties(contract, group, ""); err != nil {
 		return nil, err
 	}
@@ -75,8 +89,8 @@ func GetProperties(contract *Contract, group *Group) (*Properties, error) {
 }
 
 // GetVersions retrieves all versions for a given property
-func GetVersions(property *Property) (*Versions, error) {
-	versions := NewVersions()
+func (c *Client) GetVersions(property *Property) (*Versions, error) {
+	versions := c.NewVersions()
 	if err := versions.GetVersions(property, ""); err != nil {
 		return nil, err
 	}
@@ -85,8 +99,8 @@ func GetVersions(property *Property) (*Versions, error) {
 }
 
 // GetAvailableBehaviors retrieves all available behaviors for a property
-func GetAvailableBehaviors(property *Property) (*AvailableBehaviors, error) {
-	availableBehaviors := NewAvailableBehaviors()
+func (c *Client) GetAvailableBehaviors(property *Property) (*AvailableBehaviors, error) {
+	availableBehaviors := c.NewAvailableBehaviors()
 	if err := availableBehaviors.GetAvailableBehaviors(property); err != nil {
 		return nil, err
 	}
@@ -95,11 +109,238 @@ func GetAvailableBehaviors(property *Property) (*AvailableBehaviors, error) {
 }
 
 // GetAvailableCriteria retrieves all available criteria for a property
-func GetAvailableCriteria(property *Property) (*AvailableCriteria, error) {
-	availableCriteria := NewAvailableCriteria()
+func (c *Client) GetAvailableCriteria(property *Property) (*AvailableCriteria, error) {
+	availableCriteria := c.NewAvailableCriteria()
 	if err := availableCriteria.GetAvailableCriteria(property); err != nil {
 		return nil, err
 	}
 
 	return availableCriteria, nil
 }
+
+// GetProperty retrieves the property propertyID
+func (c *Client) GetProperty(propertyID string) (*Property, error) {
+	property := c.NewProperty(c.NewProperties())
+	property.PropertyID = propertyID
+	if err := property.GetProperty(""); err != nil {
+		return nil, err
+	}
+
+	return property, nil
+}
+
+// CreateProperty creates property, in its contract and group
+func (c *Client) CreateProperty(property *Property) error {
+	c.bind(property)
+	return property.Save("")
+}
+
+// DeleteProperty deletes property
+func (c *Client) DeleteProperty(property *Property) error {
+	c.bind(property)
+	return property.Delete("")
+}
+
+// GetLatestVersion retrieves the latest version of property, optionally the latest activated on a network
+func (c *Client) GetLatestVersion(property *Property, activatedOn NetworkValue) (*Version, error) {
+	versions := c.NewVersions()
+	versions.PropertyID = property.PropertyID
+
+	return versions.GetLatestVersion(activatedOn, "")
+}
+
+// CreateVersion creates a new version of property from createFromVersion, or from its latest version if nil
+func (c *Client) CreateVersion(property *Property, createFromVersion *Version) (*Version, error) {
+	if createFromVersion == nil {
+		var err error
+		if createFromVersion, err = c.GetLatestVersion(property, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	versions := c.NewVersions()
+	versions.PropertyID = property.PropertyID
+	version := versions.NewVersion(createFromVersion, true, "")
+	if err := version.Save(""); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// GetRules retrieves the rules of the latest version of property
+func (c *Client) GetRules(property *Property) (*Rules, error) {
+	rules := c.NewRules()
+	if err := rules.GetRules(property, ""); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// UpdateRules saves rules, to the property version they belong to
+func (c *Client) UpdateRules(rules *Rules) error {
+	c.bind(rules)
+	return rules.Save("")
+}
+
+// GetActivations retrieves all activations of property
+func (c *Client) GetActivations(property *Property) (*Activations, error) {
+	activations := c.NewActivations()
+	if err := activations.GetActivations(property); err != nil {
+		return nil, err
+	}
+
+	return activations, nil
+}
+
+// Activate activates property as described by activation
+func (c *Client) Activate(property *Property, activation *Activation, acknowledgeWarnings bool) error {
+	c.bind(activation)
+	return activation.Save(property, acknowledgeWarnings)
+}
+
+// GetActivation retrieves the activation activationID of property
+func (c *Client) GetActivation(property *Property, activationID string) (*Activation, error) {
+	activation := NewActivation(c.NewActivations())
+	activation.ActivationID = activationID
+	if _, err := activation.GetActivation(property); err != nil {
+		return nil, err
+	}
+
+	return activation, nil
+}
+
+// GetGroups retrieves all groups
+//
+// See: Client.GetGroups()
+func GetGroups() (*Groups, error) {
+	return defaultClient.GetGroups()
+}
+
+// GetContracts retrieves all contracts
+//
+// See: Client.GetContracts()
+func GetContracts() (*Contracts, error) {
+	return defaultClient.GetContracts()
+}
+
+// GetProducts retrieves all products
+//
+// See: Client.GetProducts()
+func GetProducts(contract *Contract) (*Products, error) {
+	return defaultClient.GetProducts(contract)
+}
+
+// GetEdgeHostnames retrieves all edge hostnames
+//
+// See: Client.GetEdgeHostnames()
+func GetEdgeHostnames(contract *Contract, group *Group, options string) (*EdgeHostnames, error) {
+	return defaultClient.GetEdgeHostnames(contract, group, options)
+}
+
+// GetCpCodes creates a new CpCodes struct and populates it with all CP Codes associated with a contract/group
+//
+// See: Client.GetCpCodes()
+func GetCpCodes(contract *Contract, group *Group) (*CpCodes, error) {
+	return defaultClient.GetCpCodes(contract, group)
+}
+
+// GetProperties retrieves all properties for a given contract/group
+//
+// See: Client.GetProperties()
+func GetProperties(contract *Contract, group *Group) (*Properties, error) {
+	return defaultClient.GetProperties(contract, group)
+}
+
+// GetVersions retrieves all versions for a given property
+//
+// See: Client.GetVersions()
+func GetVersions(property *Property) (*Versions, error) {
+	return defaultClient.GetVersions(property)
+}
+
+// GetAvailableBehaviors retrieves all available behaviors for a property
+//
+// See: Client.GetAvailableBehaviors()
+func GetAvailableBehaviors(property *Property) (*AvailableBehaviors, error) {
+	return defaultClient.GetAvailableBehaviors(property)
+}
+
+// GetAvailableCriteria retrieves all available criteria for a property
+//
+// See: Client.GetAvailableCriteria()
+func GetAvailableCriteria(property *Property) (*AvailableCriteria, error) {
+	return defaultClient.GetAvailableCriteria(property)
+}
+
+// GetProperty retrieves the property propertyID
+//
+// See: Client.GetProperty()
+func GetProperty(propertyID string) (*Property, error) {
+	return defaultClient.GetProperty(propertyID)
+}
+
+// GetLatestVersion retrieves the latest version of property, optionally the latest activated on a network
+//
+// See: Client.GetLatestVersion()
+func GetLatestVersion(property *Property, activatedOn NetworkValue) (*Version, error) {
+	return defaultClient.GetLatestVersion(property, activatedOn)
+}
+
+// CreateVersion creates a new version of property from createFromVersion, or from its latest version if nil
+//
+// See: Client.CreateVersion()
+func CreateVersion(property *Property, createFromVersion *Version) (*Version, error) {
+	return defaultClient.CreateVersion(property, createFromVersion)
+}
+
+// GetRules retrieves the rules of the latest version of property
+//
+// See: Client.GetRules()
+func GetRules(property *Property) (*Rules, error) {
+	return defaultClient.GetRules(property)
+}
+
+// GetActivations retrieves all activations of property
+//
+// See: Client.GetActivations()
+func GetActivations(property *Property) (*Activations, error) {
+	return defaultClient.GetActivations(property)
+}
+
+// GetActivation retrieves the activation activationID of property
+//
+// See: Client.GetActivation()
+func GetActivation(property *Property, activationID string) (*Activation, error) {
+	return defaultClient.GetActivation(property, activationID)
+}
+
+// PropertyService describes the group, contract, property, version and rule
+// operations of a Client, for consumers to depend on instead of the Client,
+// and to substitute a Fake in their tests
+type PropertyService interface {
+	GetGroups() (*Groups, error)
+	GetContracts() (*Contracts, error)
+	GetProperties(contract *Contract, group *Group) (*Properties, error)
+	GetProperty(propertyID string) (*Property, error)
+	CreateProperty(property *Property) error
+	DeleteProperty(property *Property) error
+	GetVersions(property *Property) (*Versions, error)
+	GetLatestVersion(property *Property, activatedOn NetworkValue) (*Version, error)
+	CreateVersion(property *Property, createFromVersion *Version) (*Version, error)
+	GetRules(property *Property) (*Rules, error)
+	UpdateRules(rules *Rules) error
+}
+
+// ActivationService describes the activation operations of a Client
+type ActivationService interface {
+	GetActivations(property *Property) (*Activations, error)
+	Activate(property *Property, activation *Activation, acknowledgeWarnings bool) error
+	GetActivation(property *Property, activationID string) (*Activation, error)
+}
+
+var (
+	_ PropertyService   = (*Client)(nil)
+	_ ActivationService = (*Client)(nil)
+)
@@ -0,0 +1,225 @@
+package papi
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/jsonhooks-v1"
+)
+
+// Client is a Property Manager API client bound to a client.Session
+//
+// Resources created or retrieved through a Client are bound to its Session,
+// and so are the resources they create or retrieve in turn:
+//
+//	papiClient := papi.New(client.NewSession(config))
+//	groups, err := papiClient.GetGroups()
+//
+// Unbound resources, e.g. created with NewGroups(), use the package Config.
+// So do the package-level functions.
+type Client struct {
+	session *client.Session
+}
+
+// New creates a new Client using session
+func New(session *client.Session) *Client {
+	return &Client{session: session}
+}
+
+// WithContext returns a copy of the Client making its requests with ctx.
+// The resources created or retrieved through the copy use ctx as well.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return New(c.session.WithContext(ctx))
+}
+
+// Session returns the Session of the Client
+func (c *Client) Session() *client.Session {
+	return c.session
+}
+
+// sessionBound is implemented by all resources, through client.Resource
+type sessionBound interface {
+	Session() *client.Session
+	SetSession(*client.Session)
+}
+
+// sessionOf returns the Session of the first of resources bound to one, or a
+// Session using the package Config. The first resource, usually the receiver,
+// is bound to the Session found on the others.
+func sessionOf(resources ...sessionBound) *client.Session {
+	for i, resource := range resources {
+		if resource == nil || reflect.ValueOf(resource).IsNil() {
+			continue
+		}
+		if session := resource.Session(); session != nil {
+			if i > 0 && resources[0] != nil && !reflect.ValueOf(resources[0]).IsNil() {
+				resources[0].SetSession(session)
+			}
+			return session
+		}
+	}
+	return defaultClient.session
+}
+
+// clientOf returns a Client using the Session of the first of resources bound to one
+func clientOf(resources ...sessionBound) *Client {
+	for _, resource := range resources {
+		if resource != nil && !reflect.ValueOf(resource).IsNil() && resource.Session() != nil {
+			return New(resource.Session())
+		}
+	}
+	return defaultClient
+}
+
+// inherit binds resource to the Session of parent, if any
+func inherit(resource sessionBound, parent sessionBound) {
+	if parent != nil && !reflect.ValueOf(parent).IsNil() {
+		resource.SetSession(parent.Session())
+	}
+}
+
+// bodyJSON unmarshals the Response.Body into data like client.BodyJSON, but
+// also calls the hooks of the resources nested in it, e.g. the items of a
+// collection, which so get their parent and Session
+//
+// See: jsonhooks-v1/jsonhooks.UnmarshalRecursive()
+func bodyJSON(r *http.Response, data interface{}) error {
+	if data == nil {
+		return errors.New("You must pass in an interface{}")
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	return jsonhooks.UnmarshalRecursive(body, data)
+}
+
+// bind binds resource to the Session of the Client
+func (c *Client) bind(resource sessionBound) {
+	resource.SetSession(c.session)
+}
+
+// NewGroups creates a new Groups bound to the Client
+func (c *Client) NewGroups() *Groups {
+	groups := NewGroups()
+	c.bind(groups)
+	return groups
+}
+
+// NewContracts creates a new Contracts bound to the Client
+func (c *Client) NewContracts() *Contracts {
+	contracts := NewContracts()
+	c.bind(contracts)
+	return contracts
+}
+
+// NewProducts creates a new Products bound to the Client
+func (c *Client) NewProducts() *Products {
+	products := NewProducts()
+	c.bind(products)
+	return products
+}
+
+// NewEdgeHostnames creates a new EdgeHostnames bound to the Client
+func (c *Client) NewEdgeHostnames() *EdgeHostnames {
+	edgeHostnames := NewEdgeHostnames()
+	c.bind(edgeHostnames)
+	return edgeHostnames
+}
+
+// NewCpCodes creates a new CpCodes bound to the Client
+func (c *Client) NewCpCodes(contract *Contract, group *Group) *CpCodes {
+	cpcodes := NewCpCodes(contract, group)
+	c.bind(cpcodes)
+	return cpcodes
+}
+
+// NewProperties creates a new Properties bound to the Client
+func (c *Client) NewProperties() *Properties {
+	properties := NewProperties()
+	c.bind(properties)
+	return properties
+}
+
+// NewVersions creates a new Versions bound to the Client
+func (c *Client) NewVersions() *Versions {
+	versions := NewVersions()
+	c.bind(versions)
+	return versions
+}
+
+// NewActivations creates a new Activations bound to the Client
+func (c *Client) NewActivations() *Activations {
+	activations := NewActivations()
+	c.bind(activations)
+	return activations
+}
+
+// NewAvailableBehaviors creates a new AvailableBehaviors bound to the Client
+func (c *Client) NewAvailableBehaviors() *AvailableBehaviors {
+	availableBehaviors := NewAvailableBehaviors()
+	c.bind(availableBehaviors)
+	return availableBehaviors
+}
+
+// NewAvailableCriteria creates a new AvailableCriteria bound to the Client
+func (c *Client) NewAvailableCriteria() *AvailableCriteria {
+	availableCriteria := NewAvailableCriteria()
+	c.bind(availableCriteria)
+	return availableCriteria
+}
+
+// NewClientSettings creates a new ClientSettings bound to the Client
+func (c *Client) NewClientSettings() *ClientSettings {
+	clientSettings := NewClientSettings()
+	c.bind(clientSettings)
+	return clientSettings
+}
+
+// NewCustomBehaviors creates a new CustomBehaviors bound to the Client
+func (c *Client) NewCustomBehaviors() *CustomBehaviors {
+	behaviors := NewCustomBehaviors()
+	c.bind(behaviors)
+	return behaviors
+}
+
+// NewCustomOverrides creates a new CustomOverrides bound to the Client
+func (c *Client) NewCustomOverrides() *CustomOverrides {
+	overrides := NewCustomOverrides()
+	c.bind(overrides)
+	return overrides
+}
+
+// NewHostnames creates a new Hostnames bound to the Client
+func (c *Client) NewHostnames() *Hostnames {
+	hostnames := NewHostnames()
+	c.bind(hostnames)
+	return hostnames
+}
+
+// NewRuleFormats creates a new RuleFormats bound to the Client
+func (c *Client) NewRuleFormats() *RuleFormats {
+	ruleFormats := NewRuleFormats()
+	c.bind(ruleFormats)
+	return ruleFormats
+}
+
+// NewRules creates a new Rules bound to the Client
+func (c *Client) NewRules() *Rules {
+	rules := NewRules()
+	c.bind(rules)
+	return rules
+}
+
+// NewProperty creates a new Property bound to the Client
+func (c *Client) NewProperty(parent *Properties) *Property {
+	property := NewProperty(parent)
+	c.bind(property)
+	return property
+}
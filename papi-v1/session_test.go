@@ -0,0 +1,144 @@
+package papi
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/client-v1"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/edgegrid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestClient_ResourcesKeepSession(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://akaa-session-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/papi/v1/custom-behaviors").
+		HeaderPresent("Authorization").
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		BodyString(`{
+				"accountId": "act_1-1TJZFB",
+				"customBehaviors": {
+					"items": [
+						{
+							"behaviorId": "cbe_12345",
+							"name": "DLR",
+							"status": "ACTIVE"
+						}
+					]
+				}
+			}`)
+	gock.New("https://akaa-session-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/papi/v1/custom-behaviors/cbe_12345").
+		HeaderPresent("Authorization").
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		BodyString(`{
+				"accountId": "act_1-1TJZFB",
+				"customBehaviors": {
+					"items": [
+						{
+							"behaviorId": "cbe_12345",
+							"name": "DLR",
+							"status": "ACTIVE"
+						}
+					]
+				}
+			}`)
+
+	// The package Config points to another host, so any request made with it
+	// instead of the session fails to match a mock
+	Init(config)
+	sessionConfig := config
+	sessionConfig.Host = "akaa-session-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net"
+	session := client.NewSession(sessionConfig)
+
+	behaviors := New(session).NewCustomBehaviors()
+	assert.NoError(t, behaviors.GetCustomBehaviors())
+	if assert.Len(t, behaviors.CustomBehaviors.Items, 1) {
+		behavior := behaviors.CustomBehaviors.Items[0]
+		assert.Equal(t, session, behavior.Session())
+		assert.NoError(t, behavior.GetCustomBehavior())
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestNewCustomBehavior_InheritsSession(t *testing.T) {
+	session := client.NewSession(edgegrid.Config{})
+	behaviors := New(session).NewCustomBehaviors()
+
+	assert.Equal(t, session, NewCustomBehavior(behaviors).Session())
+	assert.Nil(t, NewCustomBehavior(NewCustomBehaviors()).Session())
+}
+
+func TestGroups_UnmarshalSetsParents(t *testing.T) {
+	session := client.NewSession(edgegrid.Config{})
+	groups := New(session).NewGroups()
+
+	res := &http.Response{Body: ioutil.NopCloser(strings.NewReader(`{"accountId":"act_1-1TJZFB","groups":{"items":[{"groupId":"grp_1"},{"groupId":"grp_2"}]}}`))}
+	assert.NoError(t, bodyJSON(res, groups))
+	assert.True(t, <-groups.Complete)
+	if assert.Len(t, groups.Groups.Items, 2) {
+		for _, group := range groups.Groups.Items {
+			assert.Equal(t, groups, group.parent)
+			assert.Equal(t, session, group.Session())
+			assert.True(t, <-group.Complete)
+		}
+	}
+}
+
+func TestActivation_PollStatusWithContext(t *testing.T) {
+	session := client.NewSession(config)
+	activation := NewActivation(New(session).NewActivations())
+	activation.Status = StatusPending
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.False(t, activation.PollStatusWithContext(ctx, nil))
+	assert.False(t, <-activation.StatusChange)
+	assert.Equal(t, session, activation.Session())
+}
+
+func TestActivation_PollStatusWithContext_KeepsSession(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://akaa-session-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net").
+		Get("/papi/v1/properties/prp_1/activations/atv_1").
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		BodyString(`{"activations": {"items": [{"activationId": "atv_1", "status": "ACTIVE"}]}}`)
+
+	type pollKey struct{}
+	ctx := context.WithValue(context.Background(), pollKey{}, "poll")
+
+	sessionConfig := config
+	sessionConfig.Host = "akaa-session-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net"
+	session := client.NewSession(sessionConfig)
+	activation := NewActivation(New(session).NewActivations())
+	activation.ActivationID = "atv_1"
+	activation.Status = StatusPending
+	property := NewProperty(NewProperties())
+	property.PropertyID = "prp_1"
+
+	var bound *client.Session
+	var polled interface{}
+	session.Hooks.BeforeRequest = func(req *http.Request) {
+		bound = activation.Session()
+		polled = req.Context().Value(pollKey{})
+	}
+
+	go func() {
+		for range activation.StatusChange {
+		}
+	}()
+	assert.True(t, activation.PollStatusWithContext(ctx, property))
+	assert.Equal(t, "poll", polled)
+	assert.Same(t, session, bound)
+	assert.Same(t, session, activation.Session())
+}
@@ -31,20 +31,6 @@ func NewVersions() *Versions {
 	return version
 }
 
-// PostUnmarshalJSON is called after JSON unmarshaling into EdgeHostnames
-//
-// See: jsonhooks-v1/jsonhooks.Unmarshal()
-func (versions *Versions) PostUnmarshalJSON() error {
-	versions.Init()
-
-	for key := range versions.Versions.Items {
-		versions.Versions.Items[key].parent = versions
-	}
-	versions.Complete <- true
-
-	return nil
-}
-
 // AddVersion adds or replaces a version within the collection
 func (versions *Versions) AddVersion(version *Version) {
 	if version.PropertyVersion != 0 {
@@ -65,12 +51,12 @@ func (versions *Versions) AddVersion(version *Version) {
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#listversions
 // Endpoint: GET /papi/v1/properties/{propertyId}/versions/{?contractId,groupId}
 func (versions *Versions) GetVersions(property *Property, correlationid string) error {
+	session := sessionOf(versions, property)
 	if property == nil {
 		return errors.New("You must provide a property")
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions",
@@ -84,14 +70,14 @@ func (versions *Versions) GetVersions(property *Property, correlationid string)
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
 
 	edge.PrintHttpResponseCorrelation(res, true, correlationid)
 
-	if err = client.BodyJSON(res, versions); err != nil {
+	if err = bodyJSON(res, versions); err != nil {
 		return err
 	}
 
@@ -104,12 +90,12 @@ func (versions *Versions) GetVersions(property *Property, correlationid string)
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#getthelatestversion
 // Endpoint: GET /papi/v1/properties/{propertyId}/versions/latest{?contractId,groupId,activatedOn}
 func (versions *Versions) GetLatestVersion(activatedOn NetworkValue, correlationid string) (*Version, error) {
+	session := sessionOf(versions)
 	if activatedOn != "" {
 		activatedOn = "?activatedOn=" + activatedOn
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/latest%s",
@@ -124,7 +110,7 @@ func (versions *Versions) GetLatestVersion(activatedOn NetworkValue, correlation
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +122,7 @@ func (versions *Versions) GetLatestVersion(activatedOn NetworkValue, correlation
 	}
 
 	newVersions := NewVersions()
-	if err := client.BodyJSON(res, newVersions); err != nil {
+	if err := bodyJSON(res, newVersions); err != nil {
 		return nil, err
 	}
 
@@ -187,20 +173,29 @@ func NewVersion(parent *Versions) *Version {
 	version := &Version{parent: parent}
 	version.Init()
 
+	inherit(version, parent)
 	return version
 }
 
+// SetParent sets the Versions the Version belongs to
+func (version *Version) SetParent(parent interface{}) {
+	version.Resource.SetParent(parent)
+	if versions, ok := parent.(*Versions); ok {
+		version.parent = versions
+	}
+}
+
 // GetVersion populates a Version
 //
 // Api Docs: https://developer.akamai.com/api/luna/papi/resources.html#getaversion
 // Endpoint: /papi/v1/properties/{propertyId}/versions/{propertyVersion}{?contractId,groupId}
 func (version *Version) GetVersion(property *Property, getVersion int) error {
+	session := sessionOf(version, property)
 	if getVersion == 0 {
 		getVersion = property.LatestVersion
 	}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := session.NewRequest(
 		"GET",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions/%d",
@@ -215,7 +210,7 @@ func (version *Version) GetVersion(property *Property, getVersion int) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -227,7 +222,7 @@ func (version *Version) GetVersion(property *Property, getVersion int) error {
 	}
 
 	newVersions := NewVersions()
-	if err := client.BodyJSON(res, newVersions); err != nil {
+	if err := bodyJSON(res, newVersions); err != nil {
 		return err
 	}
 
@@ -249,6 +244,7 @@ func (version *Version) GetVersion(property *Property, getVersion int) error {
 func (version *Version) HasBeenActivated(activatedOn NetworkValue) (bool, error) {
 	properties := NewProperties()
 	property := NewProperty(properties)
+	inherit(property, version)
 	property.PropertyID = version.parent.PropertyID
 
 	property.Group = NewGroup(NewGroups())
@@ -276,12 +272,12 @@ func (version *Version) HasBeenActivated(activatedOn NetworkValue) (bool, error)
 // API Docs: https://developer.akamai.com/api/luna/papi/resources.html#createanewversion
 // Endpoint: POST /papi/v1/properties/{propertyId}/versions/{?contractId,groupId}
 func (version *Version) Save(correlationid string) error {
+	session := sessionOf(version)
 	if version.PropertyVersion != 0 {
 		return fmt.Errorf("version (%d) already exists", version.PropertyVersion)
 	}
 
-	req, err := client.NewJSONRequest(
-		Config,
+	req, err := session.NewJSONRequest(
 		"POST",
 		fmt.Sprintf(
 			"/papi/v1/properties/%s/versions",
@@ -295,7 +291,7 @@ func (version *Version) Save(correlationid string) error {
 
 	edge.PrintHttpRequestCorrelation(req, true, correlationid)
 
-	res, err := client.Do(Config, req)
+	res, err := session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -307,12 +303,11 @@ func (version *Version) Save(correlationid string) error {
 	}
 
 	var location client.JSONBody
-	if err = client.BodyJSON(res, &location); err != nil {
+	if err = bodyJSON(res, &location); err != nil {
 		return err
 	}
 
-	req, err = client.NewRequest(
-		Config,
+	req, err = session.NewRequest(
 		"GET",
 		location["versionLink"].(string),
 		nil,
@@ -323,7 +318,7 @@ func (version *Version) Save(correlationid string) error {
 
 	edge.PrintHttpRequest(req, true)
 
-	res, err = client.Do(Config, req)
+	res, err = session.Do(req)
 	if err != nil {
 		return err
 	}
@@ -335,7 +330,7 @@ func (version *Version) Save(correlationid string) error {
 	}
 
 	versions := NewVersions()
-	if err = client.BodyJSON(res, versions); err != nil {
+	if err = bodyJSON(res, versions); err != nil {
 		return err
 	}
 
@@ -45,12 +45,11 @@ type DcTrafficResponse struct {
 }
 
 // GetTrafficPerDatacenter retrieves Report Traffic per datacenter. Opt args - start, end.
-func GetTrafficPerDatacenter(domainName string, datacenterID int, optArgs map[string]string) (*DcTrafficResponse, error) {
+func (c *Client) GetTrafficPerDatacenter(domainName string, datacenterID int, optArgs map[string]string) (*DcTrafficResponse, error) {
 	stat := &DcTrafficResponse{}
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/traffic/domains/%s/datacenters/%s", domainName, strconv.Itoa(datacenterID))
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		hostURL,
 		nil,
@@ -78,7 +77,7 @@ func GetTrafficPerDatacenter(domainName string, datacenterID int, optArgs map[st
 	// print/log the request if warranted
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -102,3 +101,10 @@ func GetTrafficPerDatacenter(domainName string, datacenterID int, optArgs map[st
 		return stat, nil
 	}
 }
+
+// GetTrafficPerDatacenter retrieves Report Traffic per datacenter. Opt args - start, end.
+//
+// See: Client.GetTrafficPerDatacenter()
+func GetTrafficPerDatacenter(domainName string, datacenterID int, optArgs map[string]string) (*DcTrafficResponse, error) {
+	return defaultClient.GetTrafficPerDatacenter(domainName, datacenterID, optArgs)
+}
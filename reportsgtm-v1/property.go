@@ -87,12 +87,11 @@ type IpStatIp struct {
 }
 
 // GetIpStatusPerProperty retrieves current IP Availability Status for specified property in the given domainname.
-func GetIpStatusPerProperty(domainName string, propertyName string, optArgs map[string]string) (*IPStatusPerProperty, error) {
+func (c *Client) GetIpStatusPerProperty(domainName string, propertyName string, optArgs map[string]string) (*IPStatusPerProperty, error) {
 	stat := &IPStatusPerProperty{}
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/ip-availability/domains/%s/properties/%s", domainName, propertyName)
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		hostURL,
 		nil,
@@ -127,7 +126,7 @@ func GetIpStatusPerProperty(domainName string, propertyName string, optArgs map[
 	// print/log the request if warranted
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -152,13 +151,19 @@ func GetIpStatusPerProperty(domainName string, propertyName string, optArgs map[
 	}
 }
 
+// GetIpStatusPerProperty retrieves current IP Availability Status for specified property in the given domainname.
+//
+// See: Client.GetIpStatusPerProperty()
+func GetIpStatusPerProperty(domainName string, propertyName string, optArgs map[string]string) (*IPStatusPerProperty, error) {
+	return defaultClient.GetIpStatusPerProperty(domainName, propertyName, optArgs)
+}
+
 // GetTrafficPerProperty retrieves report traffic for the specified property in the specified domain.
-func GetTrafficPerProperty(domainName string, propertyName string, optArgs map[string]string) (*PropertyTrafficResponse, error) {
+func (c *Client) GetTrafficPerProperty(domainName string, propertyName string, optArgs map[string]string) (*PropertyTrafficResponse, error) {
 	stat := &PropertyTrafficResponse{}
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/traffic/domains/%s/properties/%s", domainName, propertyName)
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		hostURL,
 		nil,
@@ -187,7 +192,7 @@ func GetTrafficPerProperty(domainName string, propertyName string, optArgs map[s
 	// print/log the request if warranted
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -211,3 +216,10 @@ func GetTrafficPerProperty(domainName string, propertyName string, optArgs map[s
 		return stat, nil
 	}
 }
+
+// GetTrafficPerProperty retrieves report traffic for the specified property in the specified domain.
+//
+// See: Client.GetTrafficPerProperty()
+func GetTrafficPerProperty(domainName string, propertyName string, optArgs map[string]string) (*PropertyTrafficResponse, error) {
+	return defaultClient.GetTrafficPerProperty(domainName, propertyName, optArgs)
+}
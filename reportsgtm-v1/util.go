@@ -59,12 +59,11 @@ func createTimeWindow(apiResponse *APIWindowResponse) (*WindowResponse, error) {
 }
 
 // Core function to retrieve all Window API requests
-func getWindowCore(hostURL string) (*WindowResponse, error) {
+func (c *Client) getWindowCore(hostURL string) (*WindowResponse, error) {
 
 	stat := &APIWindowResponse{}
 
-	req, err := client.NewRequest(
-		Config,
+	req, err := c.session.NewRequest(
 		"GET",
 		hostURL,
 		nil,
@@ -75,7 +74,7 @@ func getWindowCore(hostURL string) (*WindowResponse, error) {
 
 	printHttpRequest(req, true)
 
-	res, err := client.Do(Config, req)
+	res, err := c.session.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -126,41 +125,76 @@ func getWindowCore(hostURL string) (*WindowResponse, error) {
 }
 
 // GetDemandWindow is a utility function that retrieves the data window for Demand category of Report APIs
-func GetDemandWindow(domainName string, propertyName string) (*WindowResponse, error) {
+func (c *Client) GetDemandWindow(domainName string, propertyName string) (*WindowResponse, error) {
 
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/demand/domains/%s/properties/%s/window", domainName, propertyName)
-	return getWindowCore(hostURL)
+	return c.getWindowCore(hostURL)
+
+}
 
+// GetDemandWindow is a utility function that retrieves the data window for Demand category of Report APIs
+//
+// See: Client.GetDemandWindow()
+func GetDemandWindow(domainName string, propertyName string) (*WindowResponse, error) {
+	return defaultClient.GetDemandWindow(domainName, propertyName)
 }
 
 // GetLatencyDomainsWindow is a utility function that retrieves the data window for Latency category of Report APIs
-func GetLatencyDomainsWindow(domainName string) (*WindowResponse, error) {
+func (c *Client) GetLatencyDomainsWindow(domainName string) (*WindowResponse, error) {
 
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/latency/domains/%s/window", domainName)
-	return getWindowCore(hostURL)
+	return c.getWindowCore(hostURL)
 
 }
 
+// GetLatencyDomainsWindow is a utility function that retrieves the data window for Latency category of Report APIs
+//
+// See: Client.GetLatencyDomainsWindow()
+func GetLatencyDomainsWindow(domainName string) (*WindowResponse, error) {
+	return defaultClient.GetLatencyDomainsWindow(domainName)
+}
+
 // GetLivenessTestsWindow is a utility function that retrieves the data window for Liveness category of Report APIs
-func GetLivenessTestsWindow() (*WindowResponse, error) {
+func (c *Client) GetLivenessTestsWindow() (*WindowResponse, error) {
 
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/liveness-tests/window")
-	return getWindowCore(hostURL)
+	return c.getWindowCore(hostURL)
+
+}
 
+// GetLivenessTestsWindow is a utility function that retrieves the data window for Liveness category of Report APIs
+//
+// See: Client.GetLivenessTestsWindow()
+func GetLivenessTestsWindow() (*WindowResponse, error) {
+	return defaultClient.GetLivenessTestsWindow()
 }
 
 // GetDatacentersTrafficWindow is a utility function that retrieves the data window for Traffic category of Report APIs
-func GetDatacentersTrafficWindow() (*WindowResponse, error) {
+func (c *Client) GetDatacentersTrafficWindow() (*WindowResponse, error) {
 
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/traffic/datacenters-window")
-	return getWindowCore(hostURL)
+	return c.getWindowCore(hostURL)
 
 }
 
+// GetDatacentersTrafficWindow is a utility function that retrieves the data window for Traffic category of Report APIs
+//
+// See: Client.GetDatacentersTrafficWindow()
+func GetDatacentersTrafficWindow() (*WindowResponse, error) {
+	return defaultClient.GetDatacentersTrafficWindow()
+}
+
 // GetPropertiesTrafficWindow is a utility function that retrieves the data window for Traffic category of Report API
-func GetPropertiesTrafficWindow() (*WindowResponse, error) {
+func (c *Client) GetPropertiesTrafficWindow() (*WindowResponse, error) {
 
 	hostURL := fmt.Sprintf("/gtm-api/v1/reports/traffic/properties-window")
-	return getWindowCore(hostURL)
+	return c.getWindowCore(hostURL)
+
+}
 
+// GetPropertiesTrafficWindow is a utility function that retrieves the data window for Traffic category of Report API
+//
+// See: Client.GetPropertiesTrafficWindow()
+func GetPropertiesTrafficWindow() (*WindowResponse, error) {
+	return defaultClient.GetPropertiesTrafficWindow()
 }